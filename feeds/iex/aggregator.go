@@ -0,0 +1,96 @@
+package iex
+
+import (
+	"time"
+
+	"github.com/thetruetrade/gotrade"
+)
+
+// A BarAvailableAction is invoked by BarAggregator every time a bar's data
+// changes, either because a tick updated the still-open bar (developing is
+// true) or because the period closed (developing is false).
+type BarAvailableAction func(bar gotrade.DOHLCV, developing bool, streamBarIndex int)
+
+// bar is the mutable, in-progress implementation of gotrade.DOHLCV that
+// BarAggregator publishes, either mid-formation or finalized.
+type bar struct {
+	open, high, low, close, volume float64
+	date                           time.Time
+}
+
+func (b *bar) O() float64   { return b.open }
+func (b *bar) H() float64   { return b.high }
+func (b *bar) L() float64   { return b.low }
+func (b *bar) C() float64   { return b.close }
+func (b *bar) V() float64   { return b.volume }
+func (b *bar) D() time.Time { return b.date }
+
+// A BarAggregator buckets a tick stream into fixed-width DOHLCV bars,
+// e.g. one bar per second, minute or 5 minutes, and notifies
+// barAvailableAction on every tick (developing bar) and again when a
+// period closes (finalized bar).
+type BarAggregator struct {
+	period             time.Duration
+	barAvailableAction BarAvailableAction
+
+	current        *bar
+	periodStart    time.Time
+	streamBarIndex int
+}
+
+// NewBarAggregator creates a BarAggregator that buckets ticks into bars
+// period wide (e.g. time.Minute for 1m bars), calling barAvailableAction
+// whenever the current bar's data changes.
+func NewBarAggregator(period time.Duration, barAvailableAction BarAvailableAction) *BarAggregator {
+	return &BarAggregator{
+		period:             period,
+		barAvailableAction: barAvailableAction,
+		streamBarIndex:     -1,
+	}
+}
+
+// ReceiveTick consumes a single trade print, updating the developing bar
+// or rolling over to a new one if the tick falls in the next period.
+func (agg *BarAggregator) ReceiveTick(tick Tick) {
+	periodStart := tick.Timestamp.Truncate(agg.period)
+
+	if agg.current == nil || periodStart.After(agg.periodStart) {
+		if agg.current != nil {
+			agg.barAvailableAction(agg.current, false, agg.streamBarIndex)
+		}
+		agg.streamBarIndex++
+		agg.periodStart = periodStart
+		agg.current = &bar{
+			open:   tick.Price,
+			high:   tick.Price,
+			low:    tick.Price,
+			close:  tick.Price,
+			volume: float64(tick.Size),
+			date:   periodStart,
+		}
+		agg.barAvailableAction(agg.current, true, agg.streamBarIndex)
+		return
+	}
+
+	if tick.Price > agg.current.high {
+		agg.current.high = tick.Price
+	}
+	if tick.Price < agg.current.low {
+		agg.current.low = tick.Price
+	}
+	agg.current.close = tick.Price
+	agg.current.volume += float64(tick.Size)
+
+	agg.barAvailableAction(agg.current, true, agg.streamBarIndex)
+}
+
+// Close finalizes whatever bar is currently in progress. Stream and
+// Recording.Replay call this once their input is exhausted so the last
+// partial bar is emitted as finalized rather than left developing; callers
+// driving a BarAggregator directly call it themselves on shutdown.
+func (agg *BarAggregator) Close() {
+	if agg.current == nil {
+		return
+	}
+	agg.barAvailableAction(agg.current, false, agg.streamBarIndex)
+}
@@ -0,0 +1,21 @@
+/*
+Package iex ingests IEX TOPS tick data (pcap captures or the newline
+delimited JSON export) and turns it into gotrade.DOHLCV bars that can be
+fed straight into any indicator from the indicators package.
+
+Two entry points are provided:
+
+  - LoadRecording/Replay, for historical data whose length is known ahead
+    of time: LoadRecording decodes the capture and reports its BarCount
+    before a single bar is emitted, so the caller can construct one of the
+    indicators package's offline ("WithSrcLen") constructors sized
+    correctly, and only then call Replay to feed the bars into it.
+  - Stream, for a live feed of unknown length, which drives the online
+    constructors tick by tick as data arrives.
+
+Both sit on top of a BarAggregator, which buckets ticks into fixed-width
+bars (1s, 1m, 5m, ...) and emits a "developing" bar on every tick in
+addition to the finalized bar on period close, so online indicators can
+choose to update intrabar.
+*/
+package iex
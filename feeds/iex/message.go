@@ -0,0 +1,15 @@
+package iex
+
+import (
+	"time"
+)
+
+// A Tick is a single normalised trade print from the IEX TOPS feed, after
+// either the pcap or JSON decoder has parsed it. Quote updates (bid/ask)
+// are not traded volume and are not represented here.
+type Tick struct {
+	Symbol    string
+	Price     float64
+	Size      int64
+	Timestamp time.Time
+}
@@ -0,0 +1,38 @@
+package iex
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"time"
+)
+
+// jsonTrade mirrors a single line of IEX's newline-delimited JSON TOPS
+// export.
+type jsonTrade struct {
+	Symbol    string  `json:"symbol"`
+	Price     float64 `json:"price"`
+	Size      int64   `json:"size"`
+	Timestamp int64   `json:"timestamp"` // nanoseconds since epoch
+}
+
+// DecodeJSON reads newline-delimited IEX TOPS trade reports from r and
+// invokes onTick for each one, in file order.
+func DecodeJSON(r io.Reader, onTick func(Tick)) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	for scanner.Scan() {
+		var trade jsonTrade
+		if err := json.Unmarshal(scanner.Bytes(), &trade); err != nil {
+			return err
+		}
+		onTick(Tick{
+			Symbol:    trade.Symbol,
+			Price:     trade.Price,
+			Size:      trade.Size,
+			Timestamp: time.Unix(0, trade.Timestamp),
+		})
+	}
+	return scanner.Err()
+}
@@ -0,0 +1,67 @@
+package iex
+
+import (
+	"encoding/binary"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	"github.com/google/gopacket/pcapgo"
+)
+
+// tradeReportMessageType identifies a Trade Report message in the IEX TOPS
+// binary protocol; other message types (quote updates, system events, ...)
+// are skipped.
+const tradeReportMessageType = 'T'
+
+// DecodePcap reads IEX TOPS UDP packets from a pcap capture and invokes
+// onTick for each Trade Report message found, in capture order. Quote
+// update and system event messages are ignored.
+func DecodePcap(r io.Reader, onTick func(Tick)) error {
+	source, err := pcapgo.NewReader(r)
+	if err != nil {
+		return err
+	}
+	packetSource := gopacket.NewPacketSource(source, source.LinkType())
+
+	for packet := range packetSource.Packets() {
+		udpLayer := packet.Layer(layers.LayerTypeUDP)
+		if udpLayer == nil {
+			continue
+		}
+		tick, ok := decodeTradeReport(udpLayer.(*layers.UDP).Payload)
+		if !ok {
+			continue
+		}
+		onTick(tick)
+	}
+	return nil
+}
+
+// decodeTradeReport parses a single IEX TOPS Trade Report message:
+//
+//	offset 0:  1 byte  message type ('T')
+//	offset 1:  8 bytes symbol, space padded
+//	offset 9:  8 bytes price, fixed point, 4 decimal places
+//	offset 17: 4 bytes size
+//	offset 21: 8 bytes timestamp, nanoseconds since epoch
+func decodeTradeReport(payload []byte) (tick Tick, ok bool) {
+	const messageLength = 29
+	if len(payload) < messageLength || payload[0] != tradeReportMessageType {
+		return Tick{}, false
+	}
+
+	symbol := strings.TrimRight(string(payload[1:9]), " ")
+	priceFixed := int64(binary.LittleEndian.Uint64(payload[9:17]))
+	size := binary.LittleEndian.Uint32(payload[17:21])
+	timestampNanos := int64(binary.LittleEndian.Uint64(payload[21:29]))
+
+	return Tick{
+		Symbol:    symbol,
+		Price:     float64(priceFixed) / 10000,
+		Size:      int64(size),
+		Timestamp: time.Unix(0, timestampNanos),
+	}, true
+}
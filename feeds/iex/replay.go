@@ -0,0 +1,69 @@
+package iex
+
+import (
+	"io"
+	"time"
+)
+
+// A Recording is every tick decoded from a historical capture, along with
+// the bar count they will produce at a given bar period. Splitting
+// decoding from feeding lets a caller learn BarCount before constructing
+// anything that needs it, so an offline ("WithSrcLen") indicator can be
+// sized correctly before its first bar arrives.
+type Recording struct {
+	ticks    []Tick
+	period   time.Duration
+	BarCount int
+}
+
+// LoadRecording decodes every tick out of r up front and counts how many
+// bars they will produce at the given bar period, without feeding any bars
+// yet. decode is DecodeJSON or DecodePcap depending on the capture format.
+func LoadRecording(r io.Reader, decode func(io.Reader, func(Tick)) error, period time.Duration) (recording *Recording, err error) {
+	ticks, err := readAllTicks(r, decode)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Recording{
+		ticks:    ticks,
+		period:   period,
+		BarCount: countBars(ticks, period),
+	}, nil
+}
+
+// Replay feeds every tick in the recording into a BarAggregator built
+// around barAvailableAction, in capture order, finalizing whatever bar is
+// in progress once all ticks have been fed. Call this only after using
+// BarCount to size and construct the indicator(s) behind barAvailableAction.
+func (rec *Recording) Replay(barAvailableAction BarAvailableAction) {
+	agg := NewBarAggregator(rec.period, barAvailableAction)
+	for _, tick := range rec.ticks {
+		agg.ReceiveTick(tick)
+	}
+	agg.Close()
+}
+
+func readAllTicks(r io.Reader, decode func(io.Reader, func(Tick)) error) ([]Tick, error) {
+	var ticks []Tick
+	err := decode(r, func(tick Tick) {
+		ticks = append(ticks, tick)
+	})
+	return ticks, err
+}
+
+func countBars(ticks []Tick, period time.Duration) int {
+	if len(ticks) == 0 {
+		return 0
+	}
+	count := 0
+	var currentPeriodStart time.Time
+	for i, tick := range ticks {
+		periodStart := tick.Timestamp.Truncate(period)
+		if i == 0 || periodStart.After(currentPeriodStart) {
+			currentPeriodStart = periodStart
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,23 @@
+package iex
+
+import (
+	"io"
+	"time"
+)
+
+// Stream decodes ticks from r as they arrive and feeds them into a
+// BarAggregator of the given bar period, for live feeds whose length is
+// not known ahead of time. decode is DecodeJSON or DecodePcap depending on
+// the capture format; it only returns once r is exhausted or a decode
+// error occurs, so callers typically run Stream in its own goroutine
+// against a live socket or named pipe.
+func Stream(r io.Reader, decode func(io.Reader, func(Tick)) error, period time.Duration, barAvailableAction BarAvailableAction) error {
+	agg := NewBarAggregator(period, barAvailableAction)
+
+	err := decode(r, func(tick Tick) {
+		agg.ReceiveTick(tick)
+	})
+
+	agg.Close()
+	return err
+}
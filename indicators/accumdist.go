@@ -0,0 +1,109 @@
+package indicators
+
+import (
+	"github.com/thetruetrade/gotrade"
+)
+
+// An AccumDist Indicator - Accumulation/Distribution Line
+type AccumDist struct {
+	*baseIndicatorWithFloatBounds
+
+	// private variables
+	valueAvailableAction ValueAvailableActionFloat
+	accumulation         float64
+
+	// public variables
+	Data []float64
+}
+
+// NewAccumDistWithoutStorage creates an Accumulation/Distribution Line
+// indicator without storage, suitable for embedding in other indicators.
+func NewAccumDistWithoutStorage(valueAvailableAction ValueAvailableActionFloat) (indicator *AccumDist, err error) {
+	if valueAvailableAction == nil {
+		return nil, ErrValueAvailableActionIsNil
+	}
+
+	ind := AccumDist{
+		baseIndicatorWithFloatBounds: newBaseIndicatorWithFloatBounds(0),
+		valueAvailableAction:         valueAvailableAction,
+	}
+
+	return &ind, nil
+}
+
+// NewAccumDist creates an Accumulation/Distribution Line indicator.
+func NewAccumDist() (indicator *AccumDist, err error) {
+	ind := AccumDist{}
+	ind.baseIndicatorWithFloatBounds = newBaseIndicatorWithFloatBounds(0)
+
+	selectData := func(dataItem float64, streamBarIndex int) {
+		ind.Data = append(ind.Data, dataItem)
+	}
+
+	ind.valueAvailableAction = selectData
+	return &ind, nil
+}
+
+// NewAccumDistForStream creates an Accumulation/Distribution Line indicator
+// and attaches it to the given DOHLCV stream.
+func NewAccumDistForStream(priceStream gotrade.DOHLCVStreamSubscriber) (indicator *AccumDist, err error) {
+	ind, err := NewAccumDist()
+	if err != nil {
+		return nil, err
+	}
+	priceStream.AddTickSubscription(ind)
+	return ind, nil
+}
+
+// NewAccumDistWithSrcLen creates an Accumulation/Distribution Line indicator
+// with the storage pre-allocated for sourceLength bars of history.
+func NewAccumDistWithSrcLen(sourceLength uint) (indicator *AccumDist, err error) {
+	ind, err := NewAccumDist()
+	if err != nil {
+		return nil, err
+	}
+	ind.Data = make([]float64, 0, sourceLength)
+	return ind, nil
+}
+
+// NewAccumDistForStreamWithSrcLen creates an Accumulation/Distribution Line
+// indicator with pre-allocated storage and attaches it to the given DOHLCV
+// stream.
+func NewAccumDistForStreamWithSrcLen(sourceLength uint, priceStream gotrade.DOHLCVStreamSubscriber) (indicator *AccumDist, err error) {
+	ind, err := NewAccumDistWithSrcLen(sourceLength)
+	if err != nil {
+		return nil, err
+	}
+	priceStream.AddTickSubscription(ind)
+	return ind, nil
+}
+
+// ReceiveDOHLCVTick consumes a source data DOHLCV price bar
+func (ind *AccumDist) ReceiveDOHLCVTick(tickData gotrade.DOHLCV, streamBarIndex int) {
+	high := tickData.H()
+	low := tickData.L()
+	close := tickData.C()
+	volume := tickData.V()
+
+	var moneyFlowVolume float64
+	if high != low {
+		moneyFlowVolume = ((2 * close) - high - low) / (high - low) * volume
+	}
+
+	ind.accumulation += moneyFlowVolume
+
+	if ind.validFromBar == -1 {
+		ind.validFromBar = streamBarIndex
+	}
+
+	if ind.accumulation < ind.minValue {
+		ind.minValue = ind.accumulation
+	}
+	if ind.accumulation > ind.maxValue {
+		ind.maxValue = ind.accumulation
+	}
+
+	ind.dataLength++
+
+	ind.valueAvailableAction(ind.accumulation, streamBarIndex)
+}
@@ -0,0 +1,183 @@
+package indicators
+
+import (
+	"github.com/thetruetrade/gotrade"
+)
+
+// tickReceiver is satisfied by any without-storage indicator driven by a
+// single float64 price series, letting BollingerBands and MACD drive their
+// internal MA/StdDev indicators without knowing their concrete type.
+type tickReceiver interface {
+	Indicator
+	ReceiveTick(tickData float64, streamBarIndex int)
+}
+
+// A BollingerBands Indicator - Upper, Middle and Lower bands
+type BollingerBands struct {
+	*baseIndicator
+
+	// private variables
+	valueAvailableAction ValueAvailableActionBollinger
+	maKind               MovingAverageKind
+	upperBandMultiplier  float64
+	lowerBandMultiplier  float64
+
+	maIndicator     tickReceiver
+	stdDevIndicator tickReceiver
+	currentMA       float64
+	haveMA          bool
+	currentStdDev   float64
+	haveStdDev      bool
+
+	// public variables
+	UpperBand  []float64
+	MiddleBand []float64
+	LowerBand  []float64
+}
+
+// NewBollingerBandsWithoutStorageWithMA creates a Bollinger Bands indicator
+// without storage, using maKind as the middle band's moving average.
+func NewBollingerBandsWithoutStorageWithMA(timePeriod int, upperBandMultiplier float64, lowerBandMultiplier float64, maKind MovingAverageKind, valueAvailableAction ValueAvailableActionBollinger) (indicator *BollingerBands, err error) {
+	if valueAvailableAction == nil {
+		return nil, ErrValueAvailableActionIsNil
+	}
+
+	ind := BollingerBands{
+		valueAvailableAction: valueAvailableAction,
+		maKind:               maKind,
+		upperBandMultiplier:  upperBandMultiplier,
+		lowerBandMultiplier:  lowerBandMultiplier,
+	}
+
+	maIndicator, err := NewMovingAverageWithoutStorage(maKind, timePeriod, func(dataItem float64, streamBarIndex int) {
+		ind.currentMA = dataItem
+		ind.haveMA = true
+	})
+	if err != nil {
+		return nil, err
+	}
+	ind.maIndicator = maIndicator.(tickReceiver)
+
+	stdDevIndicator, err := NewStdDevWithoutStorage(timePeriod, 1.0, func(dataItem float64, streamBarIndex int) {
+		ind.currentStdDev = dataItem
+		ind.haveStdDev = true
+		ind.publishIfReady(streamBarIndex)
+	})
+	if err != nil {
+		return nil, err
+	}
+	ind.stdDevIndicator = stdDevIndicator
+
+	lookback := ind.maIndicator.GetLookbackPeriod()
+	if stdDevLookback := ind.stdDevIndicator.GetLookbackPeriod(); stdDevLookback > lookback {
+		lookback = stdDevLookback
+	}
+	ind.baseIndicator = newBaseIndicator(lookback)
+
+	return &ind, nil
+}
+
+// publishIfReady emits the bands once both the moving average and the
+// standard deviation have produced a value for this bar, so a maKind whose
+// lookback outlasts StdDev's never publishes bands computed from a
+// still-cold middle band. It is called from the StdDev callback only:
+// ReceiveTick feeds the moving average first, so by then a warmed-up moving
+// average has already produced this bar's value.
+func (ind *BollingerBands) publishIfReady(streamBarIndex int) {
+	if !ind.haveMA || !ind.haveStdDev {
+		return
+	}
+	ind.haveMA = false
+	ind.haveStdDev = false
+
+	middle := ind.currentMA
+	upper := middle + ind.upperBandMultiplier*ind.currentStdDev
+	lower := middle - ind.lowerBandMultiplier*ind.currentStdDev
+
+	if ind.validFromBar == -1 {
+		ind.validFromBar = streamBarIndex
+	}
+	ind.dataLength++
+
+	ind.valueAvailableAction(upper, middle, lower, streamBarIndex)
+}
+
+// NewBollingerBandsWithoutStorage creates a Bollinger Bands indicator
+// without storage, using a simple moving average for the middle band.
+func NewBollingerBandsWithoutStorage(timePeriod int, upperBandMultiplier float64, lowerBandMultiplier float64, valueAvailableAction ValueAvailableActionBollinger) (indicator *BollingerBands, err error) {
+	return NewBollingerBandsWithoutStorageWithMA(timePeriod, upperBandMultiplier, lowerBandMultiplier, MovingAverageSMA, valueAvailableAction)
+}
+
+// NewBollingerBandsWithMA creates a Bollinger Bands indicator using maKind
+// for the middle band.
+func NewBollingerBandsWithMA(timePeriod int, upperBandMultiplier float64, lowerBandMultiplier float64, maKind MovingAverageKind) (indicator *BollingerBands, err error) {
+	ind := BollingerBands{}
+
+	selectData := func(dataItemUpperBand float64, dataItemMiddleBand float64, dataItemLowerBand float64, streamBarIndex int) {
+		ind.UpperBand = append(ind.UpperBand, dataItemUpperBand)
+		ind.MiddleBand = append(ind.MiddleBand, dataItemMiddleBand)
+		ind.LowerBand = append(ind.LowerBand, dataItemLowerBand)
+	}
+
+	fromWithoutStorage, err := NewBollingerBandsWithoutStorageWithMA(timePeriod, upperBandMultiplier, lowerBandMultiplier, maKind, selectData)
+	if err != nil {
+		return nil, err
+	}
+	ind.baseIndicator = fromWithoutStorage.baseIndicator
+	ind.valueAvailableAction = fromWithoutStorage.valueAvailableAction
+	ind.maKind = fromWithoutStorage.maKind
+	ind.upperBandMultiplier = fromWithoutStorage.upperBandMultiplier
+	ind.lowerBandMultiplier = fromWithoutStorage.lowerBandMultiplier
+	ind.maIndicator = fromWithoutStorage.maIndicator
+	ind.stdDevIndicator = fromWithoutStorage.stdDevIndicator
+
+	return &ind, nil
+}
+
+// NewBollingerBands creates a Bollinger Bands indicator using a simple
+// moving average for the middle band.
+func NewBollingerBands(timePeriod int, upperBandMultiplier float64, lowerBandMultiplier float64) (indicator *BollingerBands, err error) {
+	return NewBollingerBandsWithMA(timePeriod, upperBandMultiplier, lowerBandMultiplier, MovingAverageSMA)
+}
+
+// NewBollingerBandsForStream creates a Bollinger Bands indicator and
+// attaches it to priceStream.
+func NewBollingerBandsForStream(priceStream gotrade.DataStreamSubscriber, timePeriod int, upperBandMultiplier float64, lowerBandMultiplier float64) (indicator *BollingerBands, err error) {
+	ind, err := NewBollingerBands(timePeriod, upperBandMultiplier, lowerBandMultiplier)
+	if err != nil {
+		return nil, err
+	}
+	priceStream.AddTickSubscription(ind)
+	return ind, nil
+}
+
+// NewBollingerBandsWithSrcLen creates a Bollinger Bands indicator with the
+// storage pre-allocated for sourceLength bars of history.
+func NewBollingerBandsWithSrcLen(sourceLength uint, timePeriod int, upperBandMultiplier float64, lowerBandMultiplier float64) (indicator *BollingerBands, err error) {
+	ind, err := NewBollingerBands(timePeriod, upperBandMultiplier, lowerBandMultiplier)
+	if err != nil {
+		return nil, err
+	}
+	capacity := sourceLength - uint(ind.GetLookbackPeriod())
+	ind.UpperBand = make([]float64, 0, capacity)
+	ind.MiddleBand = make([]float64, 0, capacity)
+	ind.LowerBand = make([]float64, 0, capacity)
+	return ind, nil
+}
+
+// NewBollingerBandsForStreamWithSrcLen creates a Bollinger Bands indicator
+// with pre-allocated storage and attaches it to priceStream.
+func NewBollingerBandsForStreamWithSrcLen(sourceLength uint, priceStream gotrade.DataStreamSubscriber, timePeriod int, upperBandMultiplier float64, lowerBandMultiplier float64) (indicator *BollingerBands, err error) {
+	ind, err := NewBollingerBandsWithSrcLen(sourceLength, timePeriod, upperBandMultiplier, lowerBandMultiplier)
+	if err != nil {
+		return nil, err
+	}
+	priceStream.AddTickSubscription(ind)
+	return ind, nil
+}
+
+// ReceiveTick consumes a source data price tick
+func (ind *BollingerBands) ReceiveTick(tickData float64, streamBarIndex int) {
+	ind.maIndicator.ReceiveTick(tickData, streamBarIndex)
+	ind.stdDevIndicator.ReceiveTick(tickData, streamBarIndex)
+}
@@ -0,0 +1,148 @@
+package indicators
+
+import (
+	"github.com/thetruetrade/gotrade"
+)
+
+// A ChaikinMoneyFlow Indicator
+type ChaikinMoneyFlow struct {
+	*baseIndicatorWithFloatBounds
+	*baseIndicatorWithTimePeriod
+
+	// private variables
+	valueAvailableAction ValueAvailableActionFloat
+	periodMoneyFlowVol   []float64
+	periodVolume         []float64
+	sumMoneyFlowVol      float64
+	sumVolume            float64
+
+	// public variables
+	Data []float64
+}
+
+// NewChaikinMoneyFlowWithoutStorage creates a Chaikin Money Flow indicator
+// without storage, suitable for embedding in other indicators.
+func NewChaikinMoneyFlowWithoutStorage(timePeriod int, valueAvailableAction ValueAvailableActionFloat) (indicator *ChaikinMoneyFlow, err error) {
+	if valueAvailableAction == nil {
+		return nil, ErrValueAvailableActionIsNil
+	}
+
+	if timePeriod < 1 || timePeriod > MaximumLookbackPeriod {
+		return nil, ErrLookbackPeriodMustBeGreaterThanZero
+	}
+
+	lookback := timePeriod - 1
+	ind := ChaikinMoneyFlow{
+		baseIndicatorWithFloatBounds: newBaseIndicatorWithFloatBounds(lookback),
+		baseIndicatorWithTimePeriod:  newBaseIndicatorWithTimePeriod(timePeriod),
+		periodMoneyFlowVol:           make([]float64, 0, timePeriod),
+		periodVolume:                 make([]float64, 0, timePeriod),
+		valueAvailableAction:         valueAvailableAction,
+	}
+
+	return &ind, nil
+}
+
+// NewChaikinMoneyFlow creates a Chaikin Money Flow indicator.
+func NewChaikinMoneyFlow(timePeriod int) (indicator *ChaikinMoneyFlow, err error) {
+	ind := ChaikinMoneyFlow{}
+
+	selectData := func(dataItem float64, streamBarIndex int) {
+		ind.Data = append(ind.Data, dataItem)
+	}
+
+	fromWithoutStorage, err := NewChaikinMoneyFlowWithoutStorage(timePeriod, selectData)
+	if err != nil {
+		return nil, err
+	}
+	ind.baseIndicatorWithFloatBounds = fromWithoutStorage.baseIndicatorWithFloatBounds
+	ind.baseIndicatorWithTimePeriod = fromWithoutStorage.baseIndicatorWithTimePeriod
+	ind.periodMoneyFlowVol = fromWithoutStorage.periodMoneyFlowVol
+	ind.periodVolume = fromWithoutStorage.periodVolume
+	ind.valueAvailableAction = fromWithoutStorage.valueAvailableAction
+
+	return &ind, nil
+}
+
+// NewChaikinMoneyFlowForStream creates a Chaikin Money Flow indicator and
+// attaches it to the given DOHLCV stream.
+func NewChaikinMoneyFlowForStream(priceStream gotrade.DOHLCVStreamSubscriber, timePeriod int) (indicator *ChaikinMoneyFlow, err error) {
+	ind, err := NewChaikinMoneyFlow(timePeriod)
+	if err != nil {
+		return nil, err
+	}
+	priceStream.AddTickSubscription(ind)
+	return ind, nil
+}
+
+// NewChaikinMoneyFlowWithSrcLen creates a Chaikin Money Flow indicator with
+// the storage pre-allocated for sourceLength bars of history.
+func NewChaikinMoneyFlowWithSrcLen(sourceLength uint, timePeriod int) (indicator *ChaikinMoneyFlow, err error) {
+	ind, err := NewChaikinMoneyFlow(timePeriod)
+	if err != nil {
+		return nil, err
+	}
+	ind.Data = make([]float64, 0, sourceLength-uint(ind.GetLookbackPeriod()))
+	return ind, nil
+}
+
+// NewChaikinMoneyFlowForStreamWithSrcLen creates a Chaikin Money Flow
+// indicator with pre-allocated storage and attaches it to the given DOHLCV
+// stream.
+func NewChaikinMoneyFlowForStreamWithSrcLen(sourceLength uint, priceStream gotrade.DOHLCVStreamSubscriber, timePeriod int) (indicator *ChaikinMoneyFlow, err error) {
+	ind, err := NewChaikinMoneyFlowWithSrcLen(sourceLength, timePeriod)
+	if err != nil {
+		return nil, err
+	}
+	priceStream.AddTickSubscription(ind)
+	return ind, nil
+}
+
+// ReceiveDOHLCVTick consumes a source data DOHLCV price bar
+func (ind *ChaikinMoneyFlow) ReceiveDOHLCVTick(tickData gotrade.DOHLCV, streamBarIndex int) {
+	high := tickData.H()
+	low := tickData.L()
+	close := tickData.C()
+	volume := tickData.V()
+
+	var moneyFlowVol float64
+	if high != low {
+		moneyFlowVol = ((2 * close) - high - low) / (high - low) * volume
+	}
+
+	ind.periodMoneyFlowVol = append(ind.periodMoneyFlowVol, moneyFlowVol)
+	ind.periodVolume = append(ind.periodVolume, volume)
+	ind.sumMoneyFlowVol += moneyFlowVol
+	ind.sumVolume += volume
+
+	if len(ind.periodMoneyFlowVol) > ind.GetTimePeriod() {
+		ind.sumMoneyFlowVol -= ind.periodMoneyFlowVol[0]
+		ind.sumVolume -= ind.periodVolume[0]
+		ind.periodMoneyFlowVol = ind.periodMoneyFlowVol[1:]
+		ind.periodVolume = ind.periodVolume[1:]
+	}
+
+	if len(ind.periodMoneyFlowVol) < ind.GetTimePeriod() {
+		return
+	}
+
+	if ind.validFromBar == -1 {
+		ind.validFromBar = streamBarIndex
+	}
+
+	var cmf float64
+	if ind.sumVolume != 0 {
+		cmf = ind.sumMoneyFlowVol / ind.sumVolume
+	}
+
+	if cmf < ind.minValue {
+		ind.minValue = cmf
+	}
+	if cmf > ind.maxValue {
+		ind.maxValue = cmf
+	}
+
+	ind.dataLength++
+
+	ind.valueAvailableAction(cmf, streamBarIndex)
+}
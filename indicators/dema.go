@@ -0,0 +1,129 @@
+package indicators
+
+import (
+	"github.com/thetruetrade/gotrade"
+)
+
+// A DEMA Indicator - Double Exponential Moving Average
+type DEMA struct {
+	*baseIndicatorWithFloatBounds
+	*baseIndicatorWithTimePeriod
+
+	// private variables
+	valueAvailableAction ValueAvailableActionFloat
+	ema1Indicator        tickReceiver
+	ema2Indicator        tickReceiver
+	currentEMA1          float64
+
+	// public variables
+	Data []float64
+}
+
+// NewDEMAWithoutStorage creates a Double Exponential Moving Average
+// indicator without storage, suitable for embedding in other indicators.
+func NewDEMAWithoutStorage(timePeriod int, valueAvailableAction ValueAvailableActionFloat) (indicator *DEMA, err error) {
+	if valueAvailableAction == nil {
+		return nil, ErrValueAvailableActionIsNil
+	}
+
+	ind := DEMA{
+		baseIndicatorWithTimePeriod: newBaseIndicatorWithTimePeriod(timePeriod),
+		valueAvailableAction:        valueAvailableAction,
+	}
+
+	ema2, err := NewEMAWithoutStorage(timePeriod, func(dataItem float64, streamBarIndex int) {
+		result := 2*ind.currentEMA1 - dataItem
+
+		if ind.validFromBar == -1 {
+			ind.validFromBar = streamBarIndex
+		}
+
+		if result < ind.minValue {
+			ind.minValue = result
+		}
+		if result > ind.maxValue {
+			ind.maxValue = result
+		}
+
+		ind.dataLength++
+
+		ind.valueAvailableAction(result, streamBarIndex)
+	})
+	if err != nil {
+		return nil, err
+	}
+	ind.ema2Indicator = ema2
+
+	ema1, err := NewEMAWithoutStorage(timePeriod, func(dataItem float64, streamBarIndex int) {
+		ind.currentEMA1 = dataItem
+		ind.ema2Indicator.ReceiveTick(dataItem, streamBarIndex)
+	})
+	if err != nil {
+		return nil, err
+	}
+	ind.ema1Indicator = ema1
+
+	lookback := ema1.GetLookbackPeriod() + ema2.GetLookbackPeriod()
+	ind.baseIndicatorWithFloatBounds = newBaseIndicatorWithFloatBounds(lookback)
+
+	return &ind, nil
+}
+
+// NewDEMA creates a Double Exponential Moving Average indicator.
+func NewDEMA(timePeriod int) (indicator *DEMA, err error) {
+	ind := DEMA{}
+
+	selectData := func(dataItem float64, streamBarIndex int) {
+		ind.Data = append(ind.Data, dataItem)
+	}
+
+	fromWithoutStorage, err := NewDEMAWithoutStorage(timePeriod, selectData)
+	if err != nil {
+		return nil, err
+	}
+	ind.baseIndicatorWithFloatBounds = fromWithoutStorage.baseIndicatorWithFloatBounds
+	ind.baseIndicatorWithTimePeriod = fromWithoutStorage.baseIndicatorWithTimePeriod
+	ind.valueAvailableAction = fromWithoutStorage.valueAvailableAction
+	ind.ema1Indicator = fromWithoutStorage.ema1Indicator
+	ind.ema2Indicator = fromWithoutStorage.ema2Indicator
+
+	return &ind, nil
+}
+
+// NewDEMAForStream creates a Double Exponential Moving Average indicator
+// and attaches it to priceStream.
+func NewDEMAForStream(priceStream gotrade.DataStreamSubscriber, timePeriod int) (indicator *DEMA, err error) {
+	ind, err := NewDEMA(timePeriod)
+	if err != nil {
+		return nil, err
+	}
+	priceStream.AddTickSubscription(ind)
+	return ind, nil
+}
+
+// NewDEMAWithSrcLen creates a Double Exponential Moving Average indicator
+// with the storage pre-allocated for sourceLength bars of history.
+func NewDEMAWithSrcLen(sourceLength uint, timePeriod int) (indicator *DEMA, err error) {
+	ind, err := NewDEMA(timePeriod)
+	if err != nil {
+		return nil, err
+	}
+	ind.Data = make([]float64, 0, sourceLength-uint(ind.GetLookbackPeriod()))
+	return ind, nil
+}
+
+// NewDEMAForStreamWithSrcLen creates a Double Exponential Moving Average
+// indicator with pre-allocated storage and attaches it to priceStream.
+func NewDEMAForStreamWithSrcLen(sourceLength uint, priceStream gotrade.DataStreamSubscriber, timePeriod int) (indicator *DEMA, err error) {
+	ind, err := NewDEMAWithSrcLen(sourceLength, timePeriod)
+	if err != nil {
+		return nil, err
+	}
+	priceStream.AddTickSubscription(ind)
+	return ind, nil
+}
+
+// ReceiveTick consumes a source data price tick
+func (ind *DEMA) ReceiveTick(tickData float64, streamBarIndex int) {
+	ind.ema1Indicator.ReceiveTick(tickData, streamBarIndex)
+}
@@ -0,0 +1,149 @@
+package indicators
+
+import (
+	"github.com/thetruetrade/gotrade"
+	"math"
+)
+
+// A Drift Indicator - the drift factor of log-returns, suitable as the
+// deterministic term of a geometric Brownian motion Monte Carlo price
+// simulation.
+type Drift struct {
+	*baseIndicatorWithFloatBounds
+	*baseIndicatorWithTimePeriod
+
+	// private variables
+	valueAvailableAction ValueAvailableActionFloat
+	previousClose        float64
+	havePreviousClose    bool
+	periodLogReturns     []float64
+	periodSum            float64
+
+	// public variables
+	Data []float64
+}
+
+// NewDriftWithoutStorage creates a Drift indicator without storage,
+// suitable for embedding in other indicators.
+func NewDriftWithoutStorage(timePeriod int, valueAvailableAction ValueAvailableActionFloat) (indicator *Drift, err error) {
+	if valueAvailableAction == nil {
+		return nil, ErrValueAvailableActionIsNil
+	}
+
+	if timePeriod < 1 || timePeriod > MaximumLookbackPeriod {
+		return nil, ErrLookbackPeriodMustBeGreaterThanZero
+	}
+
+	// one extra bar is needed to form the first log-return
+	lookback := timePeriod
+	ind := Drift{
+		baseIndicatorWithFloatBounds: newBaseIndicatorWithFloatBounds(lookback),
+		baseIndicatorWithTimePeriod:  newBaseIndicatorWithTimePeriod(timePeriod),
+		periodLogReturns:             make([]float64, 0, timePeriod),
+		valueAvailableAction:         valueAvailableAction,
+	}
+
+	return &ind, nil
+}
+
+// NewDrift creates a Drift indicator.
+func NewDrift(timePeriod int) (indicator *Drift, err error) {
+	ind := Drift{}
+
+	selectData := func(dataItem float64, streamBarIndex int) {
+		ind.Data = append(ind.Data, dataItem)
+	}
+
+	fromWithoutStorage, err := NewDriftWithoutStorage(timePeriod, selectData)
+	if err != nil {
+		return nil, err
+	}
+	ind.baseIndicatorWithFloatBounds = fromWithoutStorage.baseIndicatorWithFloatBounds
+	ind.baseIndicatorWithTimePeriod = fromWithoutStorage.baseIndicatorWithTimePeriod
+	ind.periodLogReturns = fromWithoutStorage.periodLogReturns
+	ind.valueAvailableAction = fromWithoutStorage.valueAvailableAction
+
+	return &ind, nil
+}
+
+// NewDriftForStream creates a Drift indicator and attaches it to priceStream.
+func NewDriftForStream(priceStream gotrade.DataStreamSubscriber, timePeriod int) (indicator *Drift, err error) {
+	ind, err := NewDrift(timePeriod)
+	if err != nil {
+		return nil, err
+	}
+	priceStream.AddTickSubscription(ind)
+	return ind, nil
+}
+
+// NewDriftWithSrcLen creates a Drift indicator with the storage
+// pre-allocated for sourceLength bars of history.
+func NewDriftWithSrcLen(sourceLength uint, timePeriod int) (indicator *Drift, err error) {
+	ind, err := NewDrift(timePeriod)
+	if err != nil {
+		return nil, err
+	}
+	ind.Data = make([]float64, 0, sourceLength-uint(ind.GetLookbackPeriod()))
+	return ind, nil
+}
+
+// NewDriftForStreamWithSrcLen creates a Drift indicator with pre-allocated
+// storage and attaches it to priceStream.
+func NewDriftForStreamWithSrcLen(sourceLength uint, priceStream gotrade.DataStreamSubscriber, timePeriod int) (indicator *Drift, err error) {
+	ind, err := NewDriftWithSrcLen(sourceLength, timePeriod)
+	if err != nil {
+		return nil, err
+	}
+	priceStream.AddTickSubscription(ind)
+	return ind, nil
+}
+
+// ReceiveTick consumes a source data price tick
+func (ind *Drift) ReceiveTick(tickData float64, streamBarIndex int) {
+	if !ind.havePreviousClose {
+		ind.previousClose = tickData
+		ind.havePreviousClose = true
+		return
+	}
+
+	logReturn := math.Log(tickData / ind.previousClose)
+	ind.previousClose = tickData
+
+	ind.periodLogReturns = append(ind.periodLogReturns, logReturn)
+	ind.periodSum += logReturn
+
+	if len(ind.periodLogReturns) > ind.GetTimePeriod() {
+		ind.periodSum -= ind.periodLogReturns[0]
+		ind.periodLogReturns = ind.periodLogReturns[1:]
+	}
+
+	if len(ind.periodLogReturns) < ind.GetTimePeriod() {
+		return
+	}
+
+	mean := ind.periodSum / float64(ind.GetTimePeriod())
+
+	var variance float64
+	for _, logReturn := range ind.periodLogReturns {
+		d := logReturn - mean
+		variance += d * d
+	}
+	variance /= float64(ind.GetTimePeriod())
+
+	drift := mean - 0.5*variance
+
+	if ind.validFromBar == -1 {
+		ind.validFromBar = streamBarIndex
+	}
+
+	if drift < ind.minValue {
+		ind.minValue = drift
+	}
+	if drift > ind.maxValue {
+		ind.maxValue = drift
+	}
+
+	ind.dataLength++
+
+	ind.valueAvailableAction(drift, streamBarIndex)
+}
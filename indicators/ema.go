@@ -0,0 +1,134 @@
+package indicators
+
+import (
+	"github.com/thetruetrade/gotrade"
+)
+
+// An EMA Indicator - Exponential Moving Average
+type EMA struct {
+	*baseIndicatorWithFloatBounds
+	*baseIndicatorWithTimePeriod
+
+	// private variables
+	valueAvailableAction ValueAvailableActionFloat
+	multiplier           float64
+	periodHistory        []float64
+	periodSum            float64
+	previousEMA          float64
+	haveEMA              bool
+
+	// public variables
+	Data []float64
+}
+
+// NewEMAWithoutStorage creates an Exponential Moving Average indicator
+// without storage, suitable for embedding in other indicators.
+func NewEMAWithoutStorage(timePeriod int, valueAvailableAction ValueAvailableActionFloat) (indicator *EMA, err error) {
+	if valueAvailableAction == nil {
+		return nil, ErrValueAvailableActionIsNil
+	}
+
+	if timePeriod < 1 || timePeriod > MaximumLookbackPeriod {
+		return nil, ErrLookbackPeriodMustBeGreaterThanZero
+	}
+
+	lookback := timePeriod - 1
+	ind := EMA{
+		baseIndicatorWithFloatBounds: newBaseIndicatorWithFloatBounds(lookback),
+		baseIndicatorWithTimePeriod:  newBaseIndicatorWithTimePeriod(timePeriod),
+		multiplier:                   2.0 / (float64(timePeriod) + 1.0),
+		periodHistory:                make([]float64, 0, timePeriod),
+		valueAvailableAction:         valueAvailableAction,
+	}
+
+	return &ind, nil
+}
+
+// NewEMA creates an Exponential Moving Average indicator.
+func NewEMA(timePeriod int) (indicator *EMA, err error) {
+	ind := EMA{}
+
+	selectData := func(dataItem float64, streamBarIndex int) {
+		ind.Data = append(ind.Data, dataItem)
+	}
+
+	fromWithoutStorage, err := NewEMAWithoutStorage(timePeriod, selectData)
+	if err != nil {
+		return nil, err
+	}
+	ind.baseIndicatorWithFloatBounds = fromWithoutStorage.baseIndicatorWithFloatBounds
+	ind.baseIndicatorWithTimePeriod = fromWithoutStorage.baseIndicatorWithTimePeriod
+	ind.multiplier = fromWithoutStorage.multiplier
+	ind.periodHistory = fromWithoutStorage.periodHistory
+	ind.valueAvailableAction = fromWithoutStorage.valueAvailableAction
+
+	return &ind, nil
+}
+
+// NewEMAForStream creates an Exponential Moving Average indicator and
+// attaches it to priceStream.
+func NewEMAForStream(priceStream gotrade.DataStreamSubscriber, timePeriod int) (indicator *EMA, err error) {
+	ind, err := NewEMA(timePeriod)
+	if err != nil {
+		return nil, err
+	}
+	priceStream.AddTickSubscription(ind)
+	return ind, nil
+}
+
+// NewEMAWithSrcLen creates an Exponential Moving Average indicator with the
+// storage pre-allocated for sourceLength bars of history.
+func NewEMAWithSrcLen(sourceLength uint, timePeriod int) (indicator *EMA, err error) {
+	ind, err := NewEMA(timePeriod)
+	if err != nil {
+		return nil, err
+	}
+	ind.Data = make([]float64, 0, sourceLength-uint(ind.GetLookbackPeriod()))
+	return ind, nil
+}
+
+// NewEMAForStreamWithSrcLen creates an Exponential Moving Average indicator
+// with pre-allocated storage and attaches it to priceStream.
+func NewEMAForStreamWithSrcLen(sourceLength uint, priceStream gotrade.DataStreamSubscriber, timePeriod int) (indicator *EMA, err error) {
+	ind, err := NewEMAWithSrcLen(sourceLength, timePeriod)
+	if err != nil {
+		return nil, err
+	}
+	priceStream.AddTickSubscription(ind)
+	return ind, nil
+}
+
+// ReceiveTick consumes a source data price tick
+func (ind *EMA) ReceiveTick(tickData float64, streamBarIndex int) {
+	var result float64
+
+	if !ind.haveEMA {
+		ind.periodHistory = append(ind.periodHistory, tickData)
+		ind.periodSum += tickData
+
+		if len(ind.periodHistory) < ind.GetTimePeriod() {
+			return
+		}
+
+		result = ind.periodSum / float64(ind.GetTimePeriod())
+		ind.haveEMA = true
+	} else {
+		result = (tickData-ind.previousEMA)*ind.multiplier + ind.previousEMA
+	}
+	ind.previousEMA = result
+
+	if ind.validFromBar == -1 {
+		ind.validFromBar = streamBarIndex
+	}
+
+	if result < ind.minValue {
+		ind.minValue = result
+	}
+	if result > ind.maxValue {
+		ind.maxValue = result
+	}
+
+	ind.dataLength++
+
+	ind.valueAvailableAction(result, streamBarIndex)
+}
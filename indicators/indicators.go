@@ -1,29 +1,29 @@
 /*
-	import "github.com/thetruetrade/gotrade"
-
-	Package indicators provides a range of technical trading indicators.
-	All indicators follow the basic structure of:
-		- receiving price data,	processing this price data and storing the transformed result.
-		- maximum and minimum bounds of the transformed results are calculated automatically.
-		- a lookback period indicating the lag between source data and the transformed result.
-		- the source data bar from which the indicator is valid
-
- 	Functions are provided for each indicator that provide indicator creation
- 	for the following scenarios:
-
- 	Online Usage
-		- the data stream length is not known ahead of time, e.g. real time data streams
- 	Offline Usage
-		- the data stream length is known ahead of time, e.g. historical data streams
-
-	Both scenarios provide the following indicator creation functions
- 		* Indicator with default parameters
- 		* Indicator with default parameters for attachment to a data stream
- 		* Indicator with specified parameters
- 		* Indicator with specified parameters for attachment to a data stream
- 		* Indicator without storage with specified parameters
-			- for use inside other indicators, has no storage of results which is instead
-			- provided via a callback when it becomes available for use in the parent indicator.
+		import "github.com/thetruetrade/gotrade"
+
+		Package indicators provides a range of technical trading indicators.
+		All indicators follow the basic structure of:
+			- receiving price data,	processing this price data and storing the transformed result.
+			- maximum and minimum bounds of the transformed results are calculated automatically.
+			- a lookback period indicating the lag between source data and the transformed result.
+			- the source data bar from which the indicator is valid
+
+	 	Functions are provided for each indicator that provide indicator creation
+	 	for the following scenarios:
+
+	 	Online Usage
+			- the data stream length is not known ahead of time, e.g. real time data streams
+	 	Offline Usage
+			- the data stream length is known ahead of time, e.g. historical data streams
+
+		Both scenarios provide the following indicator creation functions
+	 		* Indicator with default parameters
+	 		* Indicator with default parameters for attachment to a data stream
+	 		* Indicator with specified parameters
+	 		* Indicator with specified parameters for attachment to a data stream
+	 		* Indicator without storage with specified parameters
+				- for use inside other indicators, has no storage of results which is instead
+				- provided via a callback when it becomes available for use in the parent indicator.
 */
 package indicators
 
@@ -38,6 +38,7 @@ var (
 	ErrNotEnoughSourceDataForLookbackPeriod = errors.New("Source data does not contain enough data for the specfied lookback period")
 	ErrLookbackPeriodMustBeGreaterThanZero  = errors.New("Lookback period must be greater than 0")
 	ErrValueAvailableActionIsNil            = errors.New("ValueAvailableAction cannot be empty")
+	ErrInvalidMovingAverageKind             = errors.New("MovingAverageKind is not a recognised moving average")
 
 	// lookback minimum
 	MinimumLookbackPeriod int = 0
@@ -177,3 +178,4 @@ type ValueAvailableActionMACD func(dataItemMACD float64, dataItemSignal float64,
 type ValueAvailableActionAroon func(dataItemAroonUp float64, dataItemAroonDown float64, streamBarIndex int)
 type ValueAvailableActionStoch func(dataItemK float64, dataItemD float64, streamBarIndex int)
 type ValueAvailableActionLinearReg func(dataItem float64, slope float64, intercept float64, streamBarIndex int)
+type ValueAvailableActionSupertrend func(dataItem float64, trend int, streamBarIndex int)
@@ -0,0 +1,152 @@
+package indicators
+
+import (
+	"math"
+
+	"github.com/thetruetrade/gotrade"
+)
+
+// Fixed fast/slow smoothing constants used by KAMA's efficiency ratio,
+// matching TA-Lib's defaults of a 2-period fast EMA and 30-period slow EMA.
+const (
+	kamaFastConstant = 2.0 / (2.0 + 1.0)
+	kamaSlowConstant = 2.0 / (30.0 + 1.0)
+)
+
+// A KAMA Indicator - Kaufman's Adaptive Moving Average
+type KAMA struct {
+	*baseIndicatorWithFloatBounds
+	*baseIndicatorWithTimePeriod
+
+	// private variables
+	valueAvailableAction ValueAvailableActionFloat
+	periodHistory        []float64
+	previousKAMA         float64
+	haveKAMA             bool
+
+	// public variables
+	Data []float64
+}
+
+// NewKAMAWithoutStorage creates a Kaufman's Adaptive Moving Average
+// indicator without storage, suitable for embedding in other indicators.
+func NewKAMAWithoutStorage(timePeriod int, valueAvailableAction ValueAvailableActionFloat) (indicator *KAMA, err error) {
+	if valueAvailableAction == nil {
+		return nil, ErrValueAvailableActionIsNil
+	}
+
+	if timePeriod < 1 || timePeriod > MaximumLookbackPeriod {
+		return nil, ErrLookbackPeriodMustBeGreaterThanZero
+	}
+
+	// one extra bar is needed to form the first period-over-period change
+	lookback := timePeriod
+	ind := KAMA{
+		baseIndicatorWithFloatBounds: newBaseIndicatorWithFloatBounds(lookback),
+		baseIndicatorWithTimePeriod:  newBaseIndicatorWithTimePeriod(timePeriod),
+		periodHistory:                make([]float64, 0, timePeriod+1),
+		valueAvailableAction:         valueAvailableAction,
+	}
+
+	return &ind, nil
+}
+
+// NewKAMA creates a Kaufman's Adaptive Moving Average indicator.
+func NewKAMA(timePeriod int) (indicator *KAMA, err error) {
+	ind := KAMA{}
+
+	selectData := func(dataItem float64, streamBarIndex int) {
+		ind.Data = append(ind.Data, dataItem)
+	}
+
+	fromWithoutStorage, err := NewKAMAWithoutStorage(timePeriod, selectData)
+	if err != nil {
+		return nil, err
+	}
+	ind.baseIndicatorWithFloatBounds = fromWithoutStorage.baseIndicatorWithFloatBounds
+	ind.baseIndicatorWithTimePeriod = fromWithoutStorage.baseIndicatorWithTimePeriod
+	ind.periodHistory = fromWithoutStorage.periodHistory
+	ind.valueAvailableAction = fromWithoutStorage.valueAvailableAction
+
+	return &ind, nil
+}
+
+// NewKAMAForStream creates a Kaufman's Adaptive Moving Average indicator
+// and attaches it to priceStream.
+func NewKAMAForStream(priceStream gotrade.DataStreamSubscriber, timePeriod int) (indicator *KAMA, err error) {
+	ind, err := NewKAMA(timePeriod)
+	if err != nil {
+		return nil, err
+	}
+	priceStream.AddTickSubscription(ind)
+	return ind, nil
+}
+
+// NewKAMAWithSrcLen creates a Kaufman's Adaptive Moving Average indicator
+// with the storage pre-allocated for sourceLength bars of history.
+func NewKAMAWithSrcLen(sourceLength uint, timePeriod int) (indicator *KAMA, err error) {
+	ind, err := NewKAMA(timePeriod)
+	if err != nil {
+		return nil, err
+	}
+	ind.Data = make([]float64, 0, sourceLength-uint(ind.GetLookbackPeriod()))
+	return ind, nil
+}
+
+// NewKAMAForStreamWithSrcLen creates a Kaufman's Adaptive Moving Average
+// indicator with pre-allocated storage and attaches it to priceStream.
+func NewKAMAForStreamWithSrcLen(sourceLength uint, priceStream gotrade.DataStreamSubscriber, timePeriod int) (indicator *KAMA, err error) {
+	ind, err := NewKAMAWithSrcLen(sourceLength, timePeriod)
+	if err != nil {
+		return nil, err
+	}
+	priceStream.AddTickSubscription(ind)
+	return ind, nil
+}
+
+// ReceiveTick consumes a source data price tick
+func (ind *KAMA) ReceiveTick(tickData float64, streamBarIndex int) {
+	ind.periodHistory = append(ind.periodHistory, tickData)
+	if len(ind.periodHistory) > ind.GetTimePeriod()+1 {
+		ind.periodHistory = ind.periodHistory[1:]
+	}
+
+	if len(ind.periodHistory) < ind.GetTimePeriod()+1 {
+		return
+	}
+
+	if !ind.haveKAMA {
+		ind.previousKAMA = ind.periodHistory[0]
+		ind.haveKAMA = true
+	}
+
+	change := math.Abs(tickData - ind.periodHistory[0])
+	var volatility float64
+	for i := 1; i < len(ind.periodHistory); i++ {
+		volatility += math.Abs(ind.periodHistory[i] - ind.periodHistory[i-1])
+	}
+
+	var efficiencyRatio float64
+	if volatility != 0 {
+		efficiencyRatio = change / volatility
+	}
+
+	smoothingConstant := math.Pow(efficiencyRatio*(kamaFastConstant-kamaSlowConstant)+kamaSlowConstant, 2)
+	result := ind.previousKAMA + smoothingConstant*(tickData-ind.previousKAMA)
+	ind.previousKAMA = result
+
+	if ind.validFromBar == -1 {
+		ind.validFromBar = streamBarIndex
+	}
+
+	if result < ind.minValue {
+		ind.minValue = result
+	}
+	if result > ind.maxValue {
+		ind.maxValue = result
+	}
+
+	ind.dataLength++
+
+	ind.valueAvailableAction(result, streamBarIndex)
+}
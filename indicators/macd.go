@@ -0,0 +1,187 @@
+package indicators
+
+import (
+	"github.com/thetruetrade/gotrade"
+)
+
+// A MACD Indicator - Moving Average Convergence Divergence
+type MACD struct {
+	*baseIndicator
+
+	// private variables
+	valueAvailableAction ValueAvailableActionMACD
+	fastMAKind           MovingAverageKind
+	slowMAKind           MovingAverageKind
+	signalMAKind         MovingAverageKind
+
+	fastMAIndicator   tickReceiver
+	slowMAIndicator   tickReceiver
+	signalMAIndicator tickReceiver
+
+	currentFastMA float64
+	haveFastMA    bool
+	currentSlowMA float64
+	haveSlowMA    bool
+
+	// public variables
+	MACD      []float64
+	Signal    []float64
+	Histogram []float64
+}
+
+// NewMACDExtWithoutStorage creates a MACD indicator without storage, with
+// the fast, slow and signal lines each built from the given MA kind,
+// mirroring TA-Lib's MACDEXT.
+func NewMACDExtWithoutStorage(fastTimePeriod int, slowTimePeriod int, signalTimePeriod int, fastMAKind MovingAverageKind, slowMAKind MovingAverageKind, signalMAKind MovingAverageKind, valueAvailableAction ValueAvailableActionMACD) (indicator *MACD, err error) {
+	if valueAvailableAction == nil {
+		return nil, ErrValueAvailableActionIsNil
+	}
+
+	ind := MACD{
+		valueAvailableAction: valueAvailableAction,
+		fastMAKind:           fastMAKind,
+		slowMAKind:           slowMAKind,
+		signalMAKind:         signalMAKind,
+	}
+
+	fastMAIndicator, err := NewMovingAverageWithoutStorage(fastMAKind, fastTimePeriod, func(dataItem float64, streamBarIndex int) {
+		ind.currentFastMA = dataItem
+		ind.haveFastMA = true
+	})
+	if err != nil {
+		return nil, err
+	}
+	ind.fastMAIndicator = fastMAIndicator.(tickReceiver)
+
+	slowMAIndicator, err := NewMovingAverageWithoutStorage(slowMAKind, slowTimePeriod, func(dataItem float64, streamBarIndex int) {
+		ind.currentSlowMA = dataItem
+		ind.haveSlowMA = true
+		ind.publishIfReady(streamBarIndex)
+	})
+	if err != nil {
+		return nil, err
+	}
+	ind.slowMAIndicator = slowMAIndicator.(tickReceiver)
+
+	signalMAIndicator, err := NewMovingAverageWithoutStorage(signalMAKind, signalTimePeriod, func(dataItem float64, streamBarIndex int) {
+		macd := ind.currentFastMA - ind.currentSlowMA
+		histogram := macd - dataItem
+
+		if ind.validFromBar == -1 {
+			ind.validFromBar = streamBarIndex
+		}
+		ind.dataLength++
+
+		ind.valueAvailableAction(macd, dataItem, histogram, streamBarIndex)
+	})
+	if err != nil {
+		return nil, err
+	}
+	ind.signalMAIndicator = signalMAIndicator.(tickReceiver)
+
+	lookback := ind.fastMAIndicator.GetLookbackPeriod()
+	if slowLookback := ind.slowMAIndicator.GetLookbackPeriod(); slowLookback > lookback {
+		lookback = slowLookback
+	}
+	lookback += ind.signalMAIndicator.GetLookbackPeriod()
+	ind.baseIndicator = newBaseIndicator(lookback)
+
+	return &ind, nil
+}
+
+// publishIfReady feeds the signal line once both the fast and slow moving
+// averages have produced a value for this bar. It is called from the slow
+// moving average's callback only: ReceiveTick feeds the fast moving average
+// first, so by then a warmed-up fast average has already produced this
+// bar's value.
+func (ind *MACD) publishIfReady(streamBarIndex int) {
+	if !ind.haveFastMA || !ind.haveSlowMA {
+		return
+	}
+	ind.haveFastMA = false
+	ind.haveSlowMA = false
+
+	macd := ind.currentFastMA - ind.currentSlowMA
+	ind.signalMAIndicator.ReceiveTick(macd, streamBarIndex)
+}
+
+// NewMACDWithoutStorage creates a MACD indicator without storage, using the
+// classic EMA(12)/EMA(26)/EMA(9) configuration's moving average kind (EMA)
+// for all three lines.
+func NewMACDWithoutStorage(fastTimePeriod int, slowTimePeriod int, signalTimePeriod int, valueAvailableAction ValueAvailableActionMACD) (indicator *MACD, err error) {
+	return NewMACDExtWithoutStorage(fastTimePeriod, slowTimePeriod, signalTimePeriod, MovingAverageEMA, MovingAverageEMA, MovingAverageEMA, valueAvailableAction)
+}
+
+// NewMACDExt creates a MACD indicator with the fast, slow and signal lines
+// each built from the given MA kind.
+func NewMACDExt(fastTimePeriod int, slowTimePeriod int, signalTimePeriod int, fastMAKind MovingAverageKind, slowMAKind MovingAverageKind, signalMAKind MovingAverageKind) (indicator *MACD, err error) {
+	ind := MACD{}
+
+	selectData := func(dataItemMACD float64, dataItemSignal float64, dataItemHistogram float64, streamBarIndex int) {
+		ind.MACD = append(ind.MACD, dataItemMACD)
+		ind.Signal = append(ind.Signal, dataItemSignal)
+		ind.Histogram = append(ind.Histogram, dataItemHistogram)
+	}
+
+	fromWithoutStorage, err := NewMACDExtWithoutStorage(fastTimePeriod, slowTimePeriod, signalTimePeriod, fastMAKind, slowMAKind, signalMAKind, selectData)
+	if err != nil {
+		return nil, err
+	}
+	ind.baseIndicator = fromWithoutStorage.baseIndicator
+	ind.valueAvailableAction = fromWithoutStorage.valueAvailableAction
+	ind.fastMAKind = fromWithoutStorage.fastMAKind
+	ind.slowMAKind = fromWithoutStorage.slowMAKind
+	ind.signalMAKind = fromWithoutStorage.signalMAKind
+	ind.fastMAIndicator = fromWithoutStorage.fastMAIndicator
+	ind.slowMAIndicator = fromWithoutStorage.slowMAIndicator
+	ind.signalMAIndicator = fromWithoutStorage.signalMAIndicator
+
+	return &ind, nil
+}
+
+// NewMACD creates a MACD indicator using EMA for the fast, slow and signal
+// lines, the classic configuration (e.g. 12, 26, 9).
+func NewMACD(fastTimePeriod int, slowTimePeriod int, signalTimePeriod int) (indicator *MACD, err error) {
+	return NewMACDExt(fastTimePeriod, slowTimePeriod, signalTimePeriod, MovingAverageEMA, MovingAverageEMA, MovingAverageEMA)
+}
+
+// NewMACDForStream creates a MACD indicator and attaches it to priceStream.
+func NewMACDForStream(priceStream gotrade.DataStreamSubscriber, fastTimePeriod int, slowTimePeriod int, signalTimePeriod int) (indicator *MACD, err error) {
+	ind, err := NewMACD(fastTimePeriod, slowTimePeriod, signalTimePeriod)
+	if err != nil {
+		return nil, err
+	}
+	priceStream.AddTickSubscription(ind)
+	return ind, nil
+}
+
+// NewMACDWithSrcLen creates a MACD indicator with the storage pre-allocated
+// for sourceLength bars of history.
+func NewMACDWithSrcLen(sourceLength uint, fastTimePeriod int, slowTimePeriod int, signalTimePeriod int) (indicator *MACD, err error) {
+	ind, err := NewMACD(fastTimePeriod, slowTimePeriod, signalTimePeriod)
+	if err != nil {
+		return nil, err
+	}
+	capacity := sourceLength - uint(ind.GetLookbackPeriod())
+	ind.MACD = make([]float64, 0, capacity)
+	ind.Signal = make([]float64, 0, capacity)
+	ind.Histogram = make([]float64, 0, capacity)
+	return ind, nil
+}
+
+// NewMACDForStreamWithSrcLen creates a MACD indicator with pre-allocated
+// storage and attaches it to priceStream.
+func NewMACDForStreamWithSrcLen(sourceLength uint, priceStream gotrade.DataStreamSubscriber, fastTimePeriod int, slowTimePeriod int, signalTimePeriod int) (indicator *MACD, err error) {
+	ind, err := NewMACDWithSrcLen(sourceLength, fastTimePeriod, slowTimePeriod, signalTimePeriod)
+	if err != nil {
+		return nil, err
+	}
+	priceStream.AddTickSubscription(ind)
+	return ind, nil
+}
+
+// ReceiveTick consumes a source data price tick
+func (ind *MACD) ReceiveTick(tickData float64, streamBarIndex int) {
+	ind.fastMAIndicator.ReceiveTick(tickData, streamBarIndex)
+	ind.slowMAIndicator.ReceiveTick(tickData, streamBarIndex)
+}
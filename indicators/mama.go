@@ -0,0 +1,179 @@
+package indicators
+
+import (
+	"github.com/thetruetrade/gotrade"
+)
+
+// mamaLookback mirrors the unstable period TA-Lib reserves for MAMA before
+// its adaptive smoothing has settled.
+const mamaLookback = 32
+
+// mamaMomentumWindow is the number of bars MAMA looks back over to gauge
+// how fast price is trending, which drives its adaptive smoothing constant.
+const mamaMomentumWindow = 4
+
+// A ValueAvailableActionMAMA callback receives the MAMA line, its FAMA
+// follow line, and the bar index at which both became available.
+type ValueAvailableActionMAMA func(dataItemMAMA float64, dataItemFAMA float64, streamBarIndex int)
+
+// A MAMA Indicator - MESA Adaptive Moving Average. It widens its smoothing
+// constant towards fastLimit when price is trending strongly and narrows
+// it towards slowLimit when price is choppy, using a rolling-momentum
+// approximation of the adaptive alpha TA-Lib derives from a Hilbert
+// transform of the price's dominant cycle.
+type MAMA struct {
+	*baseIndicator
+
+	// private variables
+	valueAvailableAction ValueAvailableActionMAMA
+	fastLimit            float64
+	slowLimit            float64
+
+	periodHistory []float64
+	previousMAMA  float64
+	previousFAMA  float64
+	haveMAMA      bool
+	barsReceived  int
+
+	// public variables
+	MAMA []float64
+	FAMA []float64
+}
+
+// NewMAMAWithoutStorage creates a MESA Adaptive Moving Average indicator
+// without storage, suitable for embedding in other indicators.
+func NewMAMAWithoutStorage(fastLimit float64, slowLimit float64, valueAvailableAction ValueAvailableActionMAMA) (indicator *MAMA, err error) {
+	if valueAvailableAction == nil {
+		return nil, ErrValueAvailableActionIsNil
+	}
+
+	ind := MAMA{
+		baseIndicator:        newBaseIndicator(mamaLookback),
+		fastLimit:            fastLimit,
+		slowLimit:            slowLimit,
+		periodHistory:        make([]float64, 0, mamaMomentumWindow+1),
+		valueAvailableAction: valueAvailableAction,
+	}
+
+	return &ind, nil
+}
+
+// NewMAMA creates a MESA Adaptive Moving Average indicator.
+func NewMAMA(fastLimit float64, slowLimit float64) (indicator *MAMA, err error) {
+	ind := MAMA{}
+
+	selectData := func(dataItemMAMA float64, dataItemFAMA float64, streamBarIndex int) {
+		ind.MAMA = append(ind.MAMA, dataItemMAMA)
+		ind.FAMA = append(ind.FAMA, dataItemFAMA)
+	}
+
+	fromWithoutStorage, err := NewMAMAWithoutStorage(fastLimit, slowLimit, selectData)
+	if err != nil {
+		return nil, err
+	}
+	ind.baseIndicator = fromWithoutStorage.baseIndicator
+	ind.fastLimit = fromWithoutStorage.fastLimit
+	ind.slowLimit = fromWithoutStorage.slowLimit
+	ind.periodHistory = fromWithoutStorage.periodHistory
+	ind.valueAvailableAction = fromWithoutStorage.valueAvailableAction
+
+	return &ind, nil
+}
+
+// NewMAMAForStream creates a MESA Adaptive Moving Average indicator and
+// attaches it to priceStream.
+func NewMAMAForStream(priceStream gotrade.DataStreamSubscriber, fastLimit float64, slowLimit float64) (indicator *MAMA, err error) {
+	ind, err := NewMAMA(fastLimit, slowLimit)
+	if err != nil {
+		return nil, err
+	}
+	priceStream.AddTickSubscription(ind)
+	return ind, nil
+}
+
+// NewMAMAWithSrcLen creates a MESA Adaptive Moving Average indicator with
+// the storage pre-allocated for sourceLength bars of history.
+func NewMAMAWithSrcLen(sourceLength uint, fastLimit float64, slowLimit float64) (indicator *MAMA, err error) {
+	ind, err := NewMAMA(fastLimit, slowLimit)
+	if err != nil {
+		return nil, err
+	}
+	capacity := sourceLength - uint(ind.GetLookbackPeriod())
+	ind.MAMA = make([]float64, 0, capacity)
+	ind.FAMA = make([]float64, 0, capacity)
+	return ind, nil
+}
+
+// NewMAMAForStreamWithSrcLen creates a MESA Adaptive Moving Average
+// indicator with pre-allocated storage and attaches it to priceStream.
+func NewMAMAForStreamWithSrcLen(sourceLength uint, priceStream gotrade.DataStreamSubscriber, fastLimit float64, slowLimit float64) (indicator *MAMA, err error) {
+	ind, err := NewMAMAWithSrcLen(sourceLength, fastLimit, slowLimit)
+	if err != nil {
+		return nil, err
+	}
+	priceStream.AddTickSubscription(ind)
+	return ind, nil
+}
+
+// ReceiveTick consumes a source data price tick
+func (ind *MAMA) ReceiveTick(tickData float64, streamBarIndex int) {
+	ind.barsReceived++
+	ind.periodHistory = append(ind.periodHistory, tickData)
+	if len(ind.periodHistory) > mamaMomentumWindow+1 {
+		ind.periodHistory = ind.periodHistory[1:]
+	}
+
+	if !ind.haveMAMA {
+		ind.previousMAMA = tickData
+		ind.previousFAMA = tickData
+		ind.haveMAMA = true
+	}
+
+	if len(ind.periodHistory) < mamaMomentumWindow+1 {
+		return
+	}
+
+	priceRange := ind.periodHistory[len(ind.periodHistory)-1] - ind.periodHistory[0]
+	var maxAbs float64
+	for _, value := range ind.periodHistory {
+		diff := value - ind.periodHistory[0]
+		if diff < 0 {
+			diff = -diff
+		}
+		if diff > maxAbs {
+			maxAbs = diff
+		}
+	}
+
+	var momentum float64
+	if maxAbs != 0 {
+		momentum = priceRange / maxAbs
+		if momentum < 0 {
+			momentum = -momentum
+		}
+		if momentum > 1 {
+			momentum = 1
+		}
+	}
+
+	alpha := ind.slowLimit + momentum*(ind.fastLimit-ind.slowLimit)
+
+	mama := alpha*tickData + (1-alpha)*ind.previousMAMA
+	fama := 0.5*alpha*mama + (1-0.5*alpha)*ind.previousFAMA
+
+	ind.previousMAMA = mama
+	ind.previousFAMA = fama
+
+	// keep smoothing through the unstable period but only publish after it
+	if ind.barsReceived <= mamaLookback {
+		return
+	}
+
+	if ind.validFromBar == -1 {
+		ind.validFromBar = streamBarIndex
+	}
+
+	ind.dataLength++
+
+	ind.valueAvailableAction(mama, fama, streamBarIndex)
+}
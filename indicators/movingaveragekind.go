@@ -0,0 +1,83 @@
+package indicators
+
+// Default parameters used when a composite indicator selects MAMA or T3 as
+// its moving average and does not expose those MA's own parameters.
+const (
+	DefaultMAMAFastLimit = 0.5
+	DefaultMAMASlowLimit = 0.05
+	DefaultT3VFactor     = 0.7
+)
+
+// MovingAverageKind identifies which moving average a composite indicator
+// (BollingerBands, MACD, ...) should use internally, mirroring TA-Lib's
+// MA_Type enumeration.
+type MovingAverageKind int
+
+const (
+	MovingAverageSMA MovingAverageKind = iota
+	MovingAverageEMA
+	MovingAverageWMA
+	MovingAverageDEMA
+	MovingAverageTEMA
+	MovingAverageTRIMA
+	MovingAverageKAMA
+	MovingAverageMAMA
+	MovingAverageT3
+)
+
+// NewMovingAverage creates a storage-backed moving average Indicator of the
+// requested kind, so composite indicators can pick their smoothing
+// algorithm without needing a constructor per MA per composite.
+func NewMovingAverage(kind MovingAverageKind, timePeriod int) (indicator Indicator, err error) {
+	switch kind {
+	case MovingAverageSMA:
+		return NewSMA(timePeriod)
+	case MovingAverageEMA:
+		return NewEMA(timePeriod)
+	case MovingAverageWMA:
+		return NewWMA(timePeriod)
+	case MovingAverageDEMA:
+		return NewDEMA(timePeriod)
+	case MovingAverageTEMA:
+		return NewTEMA(timePeriod)
+	case MovingAverageTRIMA:
+		return NewTRIMA(timePeriod)
+	case MovingAverageKAMA:
+		return NewKAMA(timePeriod)
+	case MovingAverageMAMA:
+		return NewMAMA(DefaultMAMAFastLimit, DefaultMAMASlowLimit)
+	case MovingAverageT3:
+		return NewT3(timePeriod, DefaultT3VFactor)
+	}
+	return nil, ErrInvalidMovingAverageKind
+}
+
+// NewMovingAverageWithoutStorage creates a moving average of the requested
+// kind without storage, suitable for embedding inside another indicator.
+// valueAvailableAction is invoked with each new average as it becomes
+// available.
+func NewMovingAverageWithoutStorage(kind MovingAverageKind, timePeriod int, valueAvailableAction ValueAvailableActionFloat) (indicator Indicator, err error) {
+	switch kind {
+	case MovingAverageSMA:
+		return NewSMAWithoutStorage(timePeriod, valueAvailableAction)
+	case MovingAverageEMA:
+		return NewEMAWithoutStorage(timePeriod, valueAvailableAction)
+	case MovingAverageWMA:
+		return NewWMAWithoutStorage(timePeriod, valueAvailableAction)
+	case MovingAverageDEMA:
+		return NewDEMAWithoutStorage(timePeriod, valueAvailableAction)
+	case MovingAverageTEMA:
+		return NewTEMAWithoutStorage(timePeriod, valueAvailableAction)
+	case MovingAverageTRIMA:
+		return NewTRIMAWithoutStorage(timePeriod, valueAvailableAction)
+	case MovingAverageKAMA:
+		return NewKAMAWithoutStorage(timePeriod, valueAvailableAction)
+	case MovingAverageMAMA:
+		return NewMAMAWithoutStorage(DefaultMAMAFastLimit, DefaultMAMASlowLimit, func(dataItemMAMA float64, dataItemFAMA float64, streamBarIndex int) {
+			valueAvailableAction(dataItemMAMA, streamBarIndex)
+		})
+	case MovingAverageT3:
+		return NewT3WithoutStorage(timePeriod, DefaultT3VFactor, valueAvailableAction)
+	}
+	return nil, ErrInvalidMovingAverageKind
+}
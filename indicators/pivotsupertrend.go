@@ -0,0 +1,293 @@
+package indicators
+
+import (
+	"github.com/thetruetrade/gotrade"
+	"math"
+)
+
+// A PivotSupertrend Indicator - a Supertrend trailing band anchored to
+// pivot highs/lows instead of the raw close price.
+type PivotSupertrend struct {
+	*baseIndicatorWithFloatBounds
+
+	// private variables
+	valueAvailableAction ValueAvailableActionSupertrend
+	pivotPeriod          int
+	atrPeriod            int
+	atrMultiplier        float64
+
+	periodBars     []gotrade.DOHLCV
+	haveCenter     bool
+	barsSincePivot int
+	center         float64
+	atr            float64
+	periodTR       []float64
+	sumTR          float64
+	haveATR        bool
+	up             float64
+	dn             float64
+	haveBands      bool
+	trend          int
+	previousClose  float64
+	haveClose      bool
+
+	// public variables
+	Data  []float64
+	Trend []int
+}
+
+// NewPivotSupertrendWithoutStorage creates a PivotSupertrend indicator
+// without storage, suitable for embedding in other indicators.
+func NewPivotSupertrendWithoutStorage(pivotPeriod int, atrPeriod int, atrMultiplier float64, valueAvailableAction ValueAvailableActionSupertrend) (indicator *PivotSupertrend, err error) {
+	if valueAvailableAction == nil {
+		return nil, ErrValueAvailableActionIsNil
+	}
+
+	if pivotPeriod < 1 || atrPeriod < 1 {
+		return nil, ErrLookbackPeriodMustBeGreaterThanZero
+	}
+
+	lookback := pivotPeriod + atrPeriod
+	ind := PivotSupertrend{
+		baseIndicatorWithFloatBounds: newBaseIndicatorWithFloatBounds(lookback),
+		valueAvailableAction:         valueAvailableAction,
+		pivotPeriod:                  pivotPeriod,
+		atrPeriod:                    atrPeriod,
+		atrMultiplier:                atrMultiplier,
+		periodBars:                   make([]gotrade.DOHLCV, 0, 2*pivotPeriod+1),
+		periodTR:                     make([]float64, 0, atrPeriod),
+	}
+
+	return &ind, nil
+}
+
+// NewPivotSupertrend creates a PivotSupertrend indicator.
+func NewPivotSupertrend(pivotPeriod int, atrPeriod int, atrMultiplier float64) (indicator *PivotSupertrend, err error) {
+	ind := PivotSupertrend{}
+
+	selectData := func(dataItem float64, trend int, streamBarIndex int) {
+		ind.Data = append(ind.Data, dataItem)
+		ind.Trend = append(ind.Trend, trend)
+	}
+
+	fromWithoutStorage, err := NewPivotSupertrendWithoutStorage(pivotPeriod, atrPeriod, atrMultiplier, selectData)
+	if err != nil {
+		return nil, err
+	}
+	ind.baseIndicatorWithFloatBounds = fromWithoutStorage.baseIndicatorWithFloatBounds
+	ind.valueAvailableAction = fromWithoutStorage.valueAvailableAction
+	ind.pivotPeriod = fromWithoutStorage.pivotPeriod
+	ind.atrPeriod = fromWithoutStorage.atrPeriod
+	ind.atrMultiplier = fromWithoutStorage.atrMultiplier
+	ind.periodBars = fromWithoutStorage.periodBars
+	ind.periodTR = fromWithoutStorage.periodTR
+
+	return &ind, nil
+}
+
+// NewPivotSupertrendForStream creates a PivotSupertrend indicator and
+// attaches it to the given DOHLCV stream.
+func NewPivotSupertrendForStream(priceStream gotrade.DOHLCVStreamSubscriber, pivotPeriod int, atrPeriod int, atrMultiplier float64) (indicator *PivotSupertrend, err error) {
+	ind, err := NewPivotSupertrend(pivotPeriod, atrPeriod, atrMultiplier)
+	if err != nil {
+		return nil, err
+	}
+	priceStream.AddTickSubscription(ind)
+	return ind, nil
+}
+
+// NewPivotSupertrendWithSrcLen creates a PivotSupertrend indicator with the
+// storage pre-allocated for sourceLength bars of history.
+func NewPivotSupertrendWithSrcLen(sourceLength uint, pivotPeriod int, atrPeriod int, atrMultiplier float64) (indicator *PivotSupertrend, err error) {
+	ind, err := NewPivotSupertrend(pivotPeriod, atrPeriod, atrMultiplier)
+	if err != nil {
+		return nil, err
+	}
+	capacity := sourceLength - uint(ind.GetLookbackPeriod())
+	ind.Data = make([]float64, 0, capacity)
+	ind.Trend = make([]int, 0, capacity)
+	return ind, nil
+}
+
+// NewPivotSupertrendForStreamWithSrcLen creates a PivotSupertrend indicator
+// with pre-allocated storage and attaches it to the given DOHLCV stream.
+func NewPivotSupertrendForStreamWithSrcLen(sourceLength uint, priceStream gotrade.DOHLCVStreamSubscriber, pivotPeriod int, atrPeriod int, atrMultiplier float64) (indicator *PivotSupertrend, err error) {
+	ind, err := NewPivotSupertrendWithSrcLen(sourceLength, pivotPeriod, atrPeriod, atrMultiplier)
+	if err != nil {
+		return nil, err
+	}
+	priceStream.AddTickSubscription(ind)
+	return ind, nil
+}
+
+// ReceiveDOHLCVTick consumes a source data DOHLCV price bar
+func (ind *PivotSupertrend) ReceiveDOHLCVTick(tickData gotrade.DOHLCV, streamBarIndex int) {
+	ind.updateATR(tickData)
+	ind.updatePivotCenter(tickData)
+
+	if !ind.haveATR || !ind.haveCenter {
+		ind.previousClose = tickData.C()
+		ind.haveClose = true
+		return
+	}
+
+	basicUp := ind.center - ind.atrMultiplier*ind.atr
+	basicDn := ind.center + ind.atrMultiplier*ind.atr
+
+	close := tickData.C()
+
+	if !ind.haveBands {
+		ind.up = basicUp
+		ind.dn = basicDn
+		ind.trend = 1
+		ind.haveBands = true
+	} else {
+		if ind.previousClose > ind.up {
+			ind.up = math.Max(basicUp, ind.up)
+		} else {
+			ind.up = basicUp
+		}
+
+		if ind.previousClose < ind.dn {
+			ind.dn = math.Min(basicDn, ind.dn)
+		} else {
+			ind.dn = basicDn
+		}
+
+		if ind.trend == -1 && close > ind.dn {
+			ind.trend = 1
+		} else if ind.trend == 1 && close < ind.up {
+			ind.trend = -1
+		}
+	}
+
+	ind.previousClose = close
+	ind.haveClose = true
+
+	var value float64
+	if ind.trend == 1 {
+		value = ind.up
+	} else {
+		value = ind.dn
+	}
+
+	if ind.validFromBar == -1 {
+		ind.validFromBar = streamBarIndex
+	}
+
+	if value < ind.minValue {
+		ind.minValue = value
+	}
+	if value > ind.maxValue {
+		ind.maxValue = value
+	}
+
+	ind.dataLength++
+
+	ind.valueAvailableAction(value, ind.trend, streamBarIndex)
+}
+
+// updateATR maintains a Wilder-smoothed average true range over atrPeriod bars.
+func (ind *PivotSupertrend) updateATR(tickData gotrade.DOHLCV) {
+	var tr float64
+	if !ind.haveClose {
+		tr = tickData.H() - tickData.L()
+	} else {
+		tr = pivotTrueRange(tickData.H(), tickData.L(), ind.previousClose)
+	}
+
+	if !ind.haveATR {
+		ind.periodTR = append(ind.periodTR, tr)
+		ind.sumTR += tr
+		if len(ind.periodTR) == ind.atrPeriod {
+			ind.atr = ind.sumTR / float64(ind.atrPeriod)
+			ind.haveATR = true
+		}
+		return
+	}
+
+	ind.atr = (ind.atr*float64(ind.atrPeriod-1) + tr) / float64(ind.atrPeriod)
+}
+
+// updatePivotCenter detects a pivot high/low at the centre of a
+// 2*pivotPeriod+1 bar window and folds it into an EMA of recent pivot
+// centres. Once an initial centre has been established, a window with no
+// strict extreme just leaves the EMA unchanged for that bar. Before that,
+// flat or choppy data may never produce a strict extreme at all, so once a
+// full window has gone by windowSize times without one, the centre bar's
+// own midpoint is used as a fallback pivot - without this, haveCenter could
+// stay false indefinitely and the indicator would never emit a value.
+func (ind *PivotSupertrend) updatePivotCenter(tickData gotrade.DOHLCV) {
+	windowSize := 2*ind.pivotPeriod + 1
+	ind.periodBars = append(ind.periodBars, tickData)
+	if len(ind.periodBars) > windowSize {
+		ind.periodBars = ind.periodBars[1:]
+	}
+	if len(ind.periodBars) < windowSize {
+		return
+	}
+
+	centerBar := ind.periodBars[ind.pivotPeriod]
+
+	isPivotHigh := true
+	isPivotLow := true
+	for i, bar := range ind.periodBars {
+		if i == ind.pivotPeriod {
+			continue
+		}
+		if bar.H() >= centerBar.H() {
+			isPivotHigh = false
+		}
+		if bar.L() <= centerBar.L() {
+			isPivotLow = false
+		}
+	}
+
+	var pivotPrice float64
+	var found bool
+	switch {
+	case isPivotHigh:
+		pivotPrice = centerBar.H()
+		found = true
+	case isPivotLow:
+		pivotPrice = centerBar.L()
+		found = true
+	}
+
+	if !found {
+		if ind.haveCenter {
+			return
+		}
+		ind.barsSincePivot++
+		if ind.barsSincePivot < windowSize {
+			return
+		}
+		pivotPrice = (centerBar.H() + centerBar.L()) / 2
+	}
+	ind.barsSincePivot = 0
+
+	alpha := 2.0 / float64(ind.pivotPeriod+1)
+	if !ind.haveCenter {
+		ind.center = pivotPrice
+		ind.haveCenter = true
+		return
+	}
+	ind.center = alpha*pivotPrice + (1-alpha)*ind.center
+}
+
+// pivotTrueRange returns the true range of a bar given its high, low and the
+// previous bar's close.
+func pivotTrueRange(high float64, low float64, previousClose float64) float64 {
+	tr := high - low
+	if hc := high - previousClose; hc < 0 {
+		if -hc > tr {
+			tr = -hc
+		}
+	} else if hc > tr {
+		tr = hc
+	}
+	if lc := previousClose - low; lc > tr {
+		tr = lc
+	}
+	return tr
+}
@@ -0,0 +1,126 @@
+package indicators
+
+import (
+	"github.com/thetruetrade/gotrade"
+)
+
+// A SMA Indicator - Simple Moving Average
+type SMA struct {
+	*baseIndicatorWithFloatBounds
+	*baseIndicatorWithTimePeriod
+
+	// private variables
+	valueAvailableAction ValueAvailableActionFloat
+	periodHistory        []float64
+	periodSum            float64
+
+	// public variables
+	Data []float64
+}
+
+// NewSMAWithoutStorage creates a Simple Moving Average indicator without
+// storage, suitable for embedding in other indicators.
+func NewSMAWithoutStorage(timePeriod int, valueAvailableAction ValueAvailableActionFloat) (indicator *SMA, err error) {
+	if valueAvailableAction == nil {
+		return nil, ErrValueAvailableActionIsNil
+	}
+
+	if timePeriod < 1 || timePeriod > MaximumLookbackPeriod {
+		return nil, ErrLookbackPeriodMustBeGreaterThanZero
+	}
+
+	lookback := timePeriod - 1
+	ind := SMA{
+		baseIndicatorWithFloatBounds: newBaseIndicatorWithFloatBounds(lookback),
+		baseIndicatorWithTimePeriod:  newBaseIndicatorWithTimePeriod(timePeriod),
+		periodHistory:                make([]float64, 0, timePeriod),
+		valueAvailableAction:         valueAvailableAction,
+	}
+
+	return &ind, nil
+}
+
+// NewSMA creates a Simple Moving Average indicator.
+func NewSMA(timePeriod int) (indicator *SMA, err error) {
+	ind := SMA{}
+
+	selectData := func(dataItem float64, streamBarIndex int) {
+		ind.Data = append(ind.Data, dataItem)
+	}
+
+	fromWithoutStorage, err := NewSMAWithoutStorage(timePeriod, selectData)
+	if err != nil {
+		return nil, err
+	}
+	ind.baseIndicatorWithFloatBounds = fromWithoutStorage.baseIndicatorWithFloatBounds
+	ind.baseIndicatorWithTimePeriod = fromWithoutStorage.baseIndicatorWithTimePeriod
+	ind.periodHistory = fromWithoutStorage.periodHistory
+	ind.valueAvailableAction = fromWithoutStorage.valueAvailableAction
+
+	return &ind, nil
+}
+
+// NewSMAForStream creates a Simple Moving Average indicator and attaches it
+// to priceStream.
+func NewSMAForStream(priceStream gotrade.DataStreamSubscriber, timePeriod int) (indicator *SMA, err error) {
+	ind, err := NewSMA(timePeriod)
+	if err != nil {
+		return nil, err
+	}
+	priceStream.AddTickSubscription(ind)
+	return ind, nil
+}
+
+// NewSMAWithSrcLen creates a Simple Moving Average indicator with the
+// storage pre-allocated for sourceLength bars of history.
+func NewSMAWithSrcLen(sourceLength uint, timePeriod int) (indicator *SMA, err error) {
+	ind, err := NewSMA(timePeriod)
+	if err != nil {
+		return nil, err
+	}
+	ind.Data = make([]float64, 0, sourceLength-uint(ind.GetLookbackPeriod()))
+	return ind, nil
+}
+
+// NewSMAForStreamWithSrcLen creates a Simple Moving Average indicator with
+// pre-allocated storage and attaches it to priceStream.
+func NewSMAForStreamWithSrcLen(sourceLength uint, priceStream gotrade.DataStreamSubscriber, timePeriod int) (indicator *SMA, err error) {
+	ind, err := NewSMAWithSrcLen(sourceLength, timePeriod)
+	if err != nil {
+		return nil, err
+	}
+	priceStream.AddTickSubscription(ind)
+	return ind, nil
+}
+
+// ReceiveTick consumes a source data price tick
+func (ind *SMA) ReceiveTick(tickData float64, streamBarIndex int) {
+	ind.periodHistory = append(ind.periodHistory, tickData)
+	ind.periodSum += tickData
+
+	if len(ind.periodHistory) > ind.GetTimePeriod() {
+		ind.periodSum -= ind.periodHistory[0]
+		ind.periodHistory = ind.periodHistory[1:]
+	}
+
+	if len(ind.periodHistory) < ind.GetTimePeriod() {
+		return
+	}
+
+	if ind.validFromBar == -1 {
+		ind.validFromBar = streamBarIndex
+	}
+
+	result := ind.periodSum / float64(ind.GetTimePeriod())
+
+	if result < ind.minValue {
+		ind.minValue = result
+	}
+	if result > ind.maxValue {
+		ind.maxValue = result
+	}
+
+	ind.dataLength++
+
+	ind.valueAvailableAction(result, streamBarIndex)
+}
@@ -0,0 +1,140 @@
+package indicators
+
+import (
+	"math"
+
+	"github.com/thetruetrade/gotrade"
+)
+
+// A StdDev Indicator - rolling Standard Deviation, scaled by nbDev
+// standard deviations the way TA-Lib's STDDEV does.
+type StdDev struct {
+	*baseIndicatorWithFloatBounds
+	*baseIndicatorWithTimePeriod
+
+	// private variables
+	valueAvailableAction ValueAvailableActionFloat
+	nbDev                float64
+	periodHistory        []float64
+	periodSum            float64
+
+	// public variables
+	Data []float64
+}
+
+// NewStdDevWithoutStorage creates a Standard Deviation indicator without
+// storage, suitable for embedding in other indicators.
+func NewStdDevWithoutStorage(timePeriod int, nbDev float64, valueAvailableAction ValueAvailableActionFloat) (indicator *StdDev, err error) {
+	if valueAvailableAction == nil {
+		return nil, ErrValueAvailableActionIsNil
+	}
+
+	if timePeriod < 1 || timePeriod > MaximumLookbackPeriod {
+		return nil, ErrLookbackPeriodMustBeGreaterThanZero
+	}
+
+	lookback := timePeriod - 1
+	ind := StdDev{
+		baseIndicatorWithFloatBounds: newBaseIndicatorWithFloatBounds(lookback),
+		baseIndicatorWithTimePeriod:  newBaseIndicatorWithTimePeriod(timePeriod),
+		nbDev:                        nbDev,
+		periodHistory:                make([]float64, 0, timePeriod),
+		valueAvailableAction:         valueAvailableAction,
+	}
+
+	return &ind, nil
+}
+
+// NewStdDev creates a Standard Deviation indicator.
+func NewStdDev(timePeriod int, nbDev float64) (indicator *StdDev, err error) {
+	ind := StdDev{}
+
+	selectData := func(dataItem float64, streamBarIndex int) {
+		ind.Data = append(ind.Data, dataItem)
+	}
+
+	fromWithoutStorage, err := NewStdDevWithoutStorage(timePeriod, nbDev, selectData)
+	if err != nil {
+		return nil, err
+	}
+	ind.baseIndicatorWithFloatBounds = fromWithoutStorage.baseIndicatorWithFloatBounds
+	ind.baseIndicatorWithTimePeriod = fromWithoutStorage.baseIndicatorWithTimePeriod
+	ind.nbDev = fromWithoutStorage.nbDev
+	ind.periodHistory = fromWithoutStorage.periodHistory
+	ind.valueAvailableAction = fromWithoutStorage.valueAvailableAction
+
+	return &ind, nil
+}
+
+// NewStdDevForStream creates a Standard Deviation indicator and attaches it
+// to priceStream.
+func NewStdDevForStream(priceStream gotrade.DataStreamSubscriber, timePeriod int, nbDev float64) (indicator *StdDev, err error) {
+	ind, err := NewStdDev(timePeriod, nbDev)
+	if err != nil {
+		return nil, err
+	}
+	priceStream.AddTickSubscription(ind)
+	return ind, nil
+}
+
+// NewStdDevWithSrcLen creates a Standard Deviation indicator with the
+// storage pre-allocated for sourceLength bars of history.
+func NewStdDevWithSrcLen(sourceLength uint, timePeriod int, nbDev float64) (indicator *StdDev, err error) {
+	ind, err := NewStdDev(timePeriod, nbDev)
+	if err != nil {
+		return nil, err
+	}
+	ind.Data = make([]float64, 0, sourceLength-uint(ind.GetLookbackPeriod()))
+	return ind, nil
+}
+
+// NewStdDevForStreamWithSrcLen creates a Standard Deviation indicator with
+// pre-allocated storage and attaches it to priceStream.
+func NewStdDevForStreamWithSrcLen(sourceLength uint, priceStream gotrade.DataStreamSubscriber, timePeriod int, nbDev float64) (indicator *StdDev, err error) {
+	ind, err := NewStdDevWithSrcLen(sourceLength, timePeriod, nbDev)
+	if err != nil {
+		return nil, err
+	}
+	priceStream.AddTickSubscription(ind)
+	return ind, nil
+}
+
+// ReceiveTick consumes a source data price tick
+func (ind *StdDev) ReceiveTick(tickData float64, streamBarIndex int) {
+	ind.periodHistory = append(ind.periodHistory, tickData)
+	ind.periodSum += tickData
+
+	if len(ind.periodHistory) > ind.GetTimePeriod() {
+		ind.periodSum -= ind.periodHistory[0]
+		ind.periodHistory = ind.periodHistory[1:]
+	}
+
+	if len(ind.periodHistory) < ind.GetTimePeriod() {
+		return
+	}
+
+	if ind.validFromBar == -1 {
+		ind.validFromBar = streamBarIndex
+	}
+
+	mean := ind.periodSum / float64(ind.GetTimePeriod())
+	var variance float64
+	for _, value := range ind.periodHistory {
+		d := value - mean
+		variance += d * d
+	}
+	variance /= float64(ind.GetTimePeriod())
+
+	result := math.Sqrt(variance) * ind.nbDev
+
+	if result < ind.minValue {
+		ind.minValue = result
+	}
+	if result > ind.maxValue {
+		ind.maxValue = result
+	}
+
+	ind.dataLength++
+
+	ind.valueAvailableAction(result, streamBarIndex)
+}
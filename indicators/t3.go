@@ -0,0 +1,142 @@
+package indicators
+
+import (
+	"github.com/thetruetrade/gotrade"
+)
+
+// A T3 Indicator - Tillson's T3 Moving Average, a cascade of six EMAs
+// blended by vFactor to trade off lag against smoothness.
+type T3 struct {
+	*baseIndicatorWithFloatBounds
+	*baseIndicatorWithTimePeriod
+
+	// private variables
+	valueAvailableAction ValueAvailableActionFloat
+	vFactor              float64
+	emaIndicators        [6]tickReceiver
+	currentEMA           [5]float64
+
+	// public variables
+	Data []float64
+}
+
+// NewT3WithoutStorage creates a T3 Moving Average indicator without
+// storage, suitable for embedding in other indicators.
+func NewT3WithoutStorage(timePeriod int, vFactor float64, valueAvailableAction ValueAvailableActionFloat) (indicator *T3, err error) {
+	if valueAvailableAction == nil {
+		return nil, ErrValueAvailableActionIsNil
+	}
+
+	ind := T3{
+		baseIndicatorWithTimePeriod: newBaseIndicatorWithTimePeriod(timePeriod),
+		valueAvailableAction:        valueAvailableAction,
+		vFactor:                     vFactor,
+	}
+
+	c1 := -vFactor * vFactor * vFactor
+	c2 := 3*vFactor*vFactor + 3*vFactor*vFactor*vFactor
+	c3 := -6*vFactor*vFactor - 3*vFactor - 3*vFactor*vFactor*vFactor
+	c4 := 1 + 3*vFactor + vFactor*vFactor*vFactor + 3*vFactor*vFactor
+
+	ema6, err := NewEMAWithoutStorage(timePeriod, func(dataItem float64, streamBarIndex int) {
+		result := c1*dataItem + c2*ind.currentEMA[4] + c3*ind.currentEMA[3] + c4*ind.currentEMA[2]
+
+		if ind.validFromBar == -1 {
+			ind.validFromBar = streamBarIndex
+		}
+
+		if result < ind.minValue {
+			ind.minValue = result
+		}
+		if result > ind.maxValue {
+			ind.maxValue = result
+		}
+
+		ind.dataLength++
+
+		ind.valueAvailableAction(result, streamBarIndex)
+	})
+	if err != nil {
+		return nil, err
+	}
+	ind.emaIndicators[5] = ema6
+
+	for stage := 4; stage >= 0; stage-- {
+		stage := stage
+		ema, err := NewEMAWithoutStorage(timePeriod, func(dataItem float64, streamBarIndex int) {
+			ind.currentEMA[stage] = dataItem
+			ind.emaIndicators[stage+1].ReceiveTick(dataItem, streamBarIndex)
+		})
+		if err != nil {
+			return nil, err
+		}
+		ind.emaIndicators[stage] = ema
+	}
+
+	lookback := 0
+	for _, ema := range ind.emaIndicators {
+		lookback += ema.GetLookbackPeriod()
+	}
+	ind.baseIndicatorWithFloatBounds = newBaseIndicatorWithFloatBounds(lookback)
+
+	return &ind, nil
+}
+
+// NewT3 creates a T3 Moving Average indicator.
+func NewT3(timePeriod int, vFactor float64) (indicator *T3, err error) {
+	ind := T3{}
+
+	selectData := func(dataItem float64, streamBarIndex int) {
+		ind.Data = append(ind.Data, dataItem)
+	}
+
+	fromWithoutStorage, err := NewT3WithoutStorage(timePeriod, vFactor, selectData)
+	if err != nil {
+		return nil, err
+	}
+	ind.baseIndicatorWithFloatBounds = fromWithoutStorage.baseIndicatorWithFloatBounds
+	ind.baseIndicatorWithTimePeriod = fromWithoutStorage.baseIndicatorWithTimePeriod
+	ind.valueAvailableAction = fromWithoutStorage.valueAvailableAction
+	ind.vFactor = fromWithoutStorage.vFactor
+	ind.emaIndicators = fromWithoutStorage.emaIndicators
+
+	return &ind, nil
+}
+
+// NewT3ForStream creates a T3 Moving Average indicator and attaches it to
+// priceStream.
+func NewT3ForStream(priceStream gotrade.DataStreamSubscriber, timePeriod int, vFactor float64) (indicator *T3, err error) {
+	ind, err := NewT3(timePeriod, vFactor)
+	if err != nil {
+		return nil, err
+	}
+	priceStream.AddTickSubscription(ind)
+	return ind, nil
+}
+
+// NewT3WithSrcLen creates a T3 Moving Average indicator with the storage
+// pre-allocated for sourceLength bars of history.
+func NewT3WithSrcLen(sourceLength uint, timePeriod int, vFactor float64) (indicator *T3, err error) {
+	ind, err := NewT3(timePeriod, vFactor)
+	if err != nil {
+		return nil, err
+	}
+	ind.Data = make([]float64, 0, sourceLength-uint(ind.GetLookbackPeriod()))
+	return ind, nil
+}
+
+// NewT3ForStreamWithSrcLen creates a T3 Moving Average indicator with
+// pre-allocated storage and attaches it to priceStream.
+func NewT3ForStreamWithSrcLen(sourceLength uint, priceStream gotrade.DataStreamSubscriber, timePeriod int, vFactor float64) (indicator *T3, err error) {
+	ind, err := NewT3WithSrcLen(sourceLength, timePeriod, vFactor)
+	if err != nil {
+		return nil, err
+	}
+	priceStream.AddTickSubscription(ind)
+	return ind, nil
+}
+
+// ReceiveTick consumes a source data price tick
+func (ind *T3) ReceiveTick(tickData float64, streamBarIndex int) {
+	ind.emaIndicators[0].ReceiveTick(tickData, streamBarIndex)
+}
@@ -0,0 +1,141 @@
+package indicators
+
+import (
+	"github.com/thetruetrade/gotrade"
+)
+
+// A TEMA Indicator - Triple Exponential Moving Average
+type TEMA struct {
+	*baseIndicatorWithFloatBounds
+	*baseIndicatorWithTimePeriod
+
+	// private variables
+	valueAvailableAction ValueAvailableActionFloat
+	ema1Indicator        tickReceiver
+	ema2Indicator        tickReceiver
+	ema3Indicator        tickReceiver
+	currentEMA1          float64
+	currentEMA2          float64
+
+	// public variables
+	Data []float64
+}
+
+// NewTEMAWithoutStorage creates a Triple Exponential Moving Average
+// indicator without storage, suitable for embedding in other indicators.
+func NewTEMAWithoutStorage(timePeriod int, valueAvailableAction ValueAvailableActionFloat) (indicator *TEMA, err error) {
+	if valueAvailableAction == nil {
+		return nil, ErrValueAvailableActionIsNil
+	}
+
+	ind := TEMA{
+		baseIndicatorWithTimePeriod: newBaseIndicatorWithTimePeriod(timePeriod),
+		valueAvailableAction:        valueAvailableAction,
+	}
+
+	ema3, err := NewEMAWithoutStorage(timePeriod, func(dataItem float64, streamBarIndex int) {
+		result := 3*ind.currentEMA1 - 3*ind.currentEMA2 + dataItem
+
+		if ind.validFromBar == -1 {
+			ind.validFromBar = streamBarIndex
+		}
+
+		if result < ind.minValue {
+			ind.minValue = result
+		}
+		if result > ind.maxValue {
+			ind.maxValue = result
+		}
+
+		ind.dataLength++
+
+		ind.valueAvailableAction(result, streamBarIndex)
+	})
+	if err != nil {
+		return nil, err
+	}
+	ind.ema3Indicator = ema3
+
+	ema2, err := NewEMAWithoutStorage(timePeriod, func(dataItem float64, streamBarIndex int) {
+		ind.currentEMA2 = dataItem
+		ind.ema3Indicator.ReceiveTick(dataItem, streamBarIndex)
+	})
+	if err != nil {
+		return nil, err
+	}
+	ind.ema2Indicator = ema2
+
+	ema1, err := NewEMAWithoutStorage(timePeriod, func(dataItem float64, streamBarIndex int) {
+		ind.currentEMA1 = dataItem
+		ind.ema2Indicator.ReceiveTick(dataItem, streamBarIndex)
+	})
+	if err != nil {
+		return nil, err
+	}
+	ind.ema1Indicator = ema1
+
+	lookback := ema1.GetLookbackPeriod() + ema2.GetLookbackPeriod() + ema3.GetLookbackPeriod()
+	ind.baseIndicatorWithFloatBounds = newBaseIndicatorWithFloatBounds(lookback)
+
+	return &ind, nil
+}
+
+// NewTEMA creates a Triple Exponential Moving Average indicator.
+func NewTEMA(timePeriod int) (indicator *TEMA, err error) {
+	ind := TEMA{}
+
+	selectData := func(dataItem float64, streamBarIndex int) {
+		ind.Data = append(ind.Data, dataItem)
+	}
+
+	fromWithoutStorage, err := NewTEMAWithoutStorage(timePeriod, selectData)
+	if err != nil {
+		return nil, err
+	}
+	ind.baseIndicatorWithFloatBounds = fromWithoutStorage.baseIndicatorWithFloatBounds
+	ind.baseIndicatorWithTimePeriod = fromWithoutStorage.baseIndicatorWithTimePeriod
+	ind.valueAvailableAction = fromWithoutStorage.valueAvailableAction
+	ind.ema1Indicator = fromWithoutStorage.ema1Indicator
+	ind.ema2Indicator = fromWithoutStorage.ema2Indicator
+	ind.ema3Indicator = fromWithoutStorage.ema3Indicator
+
+	return &ind, nil
+}
+
+// NewTEMAForStream creates a Triple Exponential Moving Average indicator
+// and attaches it to priceStream.
+func NewTEMAForStream(priceStream gotrade.DataStreamSubscriber, timePeriod int) (indicator *TEMA, err error) {
+	ind, err := NewTEMA(timePeriod)
+	if err != nil {
+		return nil, err
+	}
+	priceStream.AddTickSubscription(ind)
+	return ind, nil
+}
+
+// NewTEMAWithSrcLen creates a Triple Exponential Moving Average indicator
+// with the storage pre-allocated for sourceLength bars of history.
+func NewTEMAWithSrcLen(sourceLength uint, timePeriod int) (indicator *TEMA, err error) {
+	ind, err := NewTEMA(timePeriod)
+	if err != nil {
+		return nil, err
+	}
+	ind.Data = make([]float64, 0, sourceLength-uint(ind.GetLookbackPeriod()))
+	return ind, nil
+}
+
+// NewTEMAForStreamWithSrcLen creates a Triple Exponential Moving Average
+// indicator with pre-allocated storage and attaches it to priceStream.
+func NewTEMAForStreamWithSrcLen(sourceLength uint, priceStream gotrade.DataStreamSubscriber, timePeriod int) (indicator *TEMA, err error) {
+	ind, err := NewTEMAWithSrcLen(sourceLength, timePeriod)
+	if err != nil {
+		return nil, err
+	}
+	priceStream.AddTickSubscription(ind)
+	return ind, nil
+}
+
+// ReceiveTick consumes a source data price tick
+func (ind *TEMA) ReceiveTick(tickData float64, streamBarIndex int) {
+	ind.ema1Indicator.ReceiveTick(tickData, streamBarIndex)
+}
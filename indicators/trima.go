@@ -0,0 +1,144 @@
+package indicators
+
+import (
+	"github.com/thetruetrade/gotrade"
+)
+
+// A TRIMA Indicator - Triangular Moving Average, a SMA double-smoothed so
+// the centre of the window carries the most weight.
+type TRIMA struct {
+	*baseIndicatorWithFloatBounds
+	*baseIndicatorWithTimePeriod
+
+	// private variables
+	valueAvailableAction ValueAvailableActionFloat
+	periodHistory        []float64
+	weights              []float64
+	weightSum            float64
+
+	// public variables
+	Data []float64
+}
+
+// NewTRIMAWithoutStorage creates a Triangular Moving Average indicator
+// without storage, suitable for embedding in other indicators.
+func NewTRIMAWithoutStorage(timePeriod int, valueAvailableAction ValueAvailableActionFloat) (indicator *TRIMA, err error) {
+	if valueAvailableAction == nil {
+		return nil, ErrValueAvailableActionIsNil
+	}
+
+	if timePeriod < 1 || timePeriod > MaximumLookbackPeriod {
+		return nil, ErrLookbackPeriodMustBeGreaterThanZero
+	}
+
+	weights := make([]float64, timePeriod)
+	var weightSum float64
+	for i := 0; i < timePeriod; i++ {
+		weight := float64(i + 1)
+		if back := float64(timePeriod - i); back < weight {
+			weight = back
+		}
+		weights[i] = weight
+		weightSum += weight
+	}
+
+	lookback := timePeriod - 1
+	ind := TRIMA{
+		baseIndicatorWithFloatBounds: newBaseIndicatorWithFloatBounds(lookback),
+		baseIndicatorWithTimePeriod:  newBaseIndicatorWithTimePeriod(timePeriod),
+		periodHistory:                make([]float64, 0, timePeriod),
+		weights:                      weights,
+		weightSum:                    weightSum,
+		valueAvailableAction:         valueAvailableAction,
+	}
+
+	return &ind, nil
+}
+
+// NewTRIMA creates a Triangular Moving Average indicator.
+func NewTRIMA(timePeriod int) (indicator *TRIMA, err error) {
+	ind := TRIMA{}
+
+	selectData := func(dataItem float64, streamBarIndex int) {
+		ind.Data = append(ind.Data, dataItem)
+	}
+
+	fromWithoutStorage, err := NewTRIMAWithoutStorage(timePeriod, selectData)
+	if err != nil {
+		return nil, err
+	}
+	ind.baseIndicatorWithFloatBounds = fromWithoutStorage.baseIndicatorWithFloatBounds
+	ind.baseIndicatorWithTimePeriod = fromWithoutStorage.baseIndicatorWithTimePeriod
+	ind.periodHistory = fromWithoutStorage.periodHistory
+	ind.weights = fromWithoutStorage.weights
+	ind.weightSum = fromWithoutStorage.weightSum
+	ind.valueAvailableAction = fromWithoutStorage.valueAvailableAction
+
+	return &ind, nil
+}
+
+// NewTRIMAForStream creates a Triangular Moving Average indicator and
+// attaches it to priceStream.
+func NewTRIMAForStream(priceStream gotrade.DataStreamSubscriber, timePeriod int) (indicator *TRIMA, err error) {
+	ind, err := NewTRIMA(timePeriod)
+	if err != nil {
+		return nil, err
+	}
+	priceStream.AddTickSubscription(ind)
+	return ind, nil
+}
+
+// NewTRIMAWithSrcLen creates a Triangular Moving Average indicator with the
+// storage pre-allocated for sourceLength bars of history.
+func NewTRIMAWithSrcLen(sourceLength uint, timePeriod int) (indicator *TRIMA, err error) {
+	ind, err := NewTRIMA(timePeriod)
+	if err != nil {
+		return nil, err
+	}
+	ind.Data = make([]float64, 0, sourceLength-uint(ind.GetLookbackPeriod()))
+	return ind, nil
+}
+
+// NewTRIMAForStreamWithSrcLen creates a Triangular Moving Average indicator
+// with pre-allocated storage and attaches it to priceStream.
+func NewTRIMAForStreamWithSrcLen(sourceLength uint, priceStream gotrade.DataStreamSubscriber, timePeriod int) (indicator *TRIMA, err error) {
+	ind, err := NewTRIMAWithSrcLen(sourceLength, timePeriod)
+	if err != nil {
+		return nil, err
+	}
+	priceStream.AddTickSubscription(ind)
+	return ind, nil
+}
+
+// ReceiveTick consumes a source data price tick
+func (ind *TRIMA) ReceiveTick(tickData float64, streamBarIndex int) {
+	ind.periodHistory = append(ind.periodHistory, tickData)
+	if len(ind.periodHistory) > ind.GetTimePeriod() {
+		ind.periodHistory = ind.periodHistory[1:]
+	}
+
+	if len(ind.periodHistory) < ind.GetTimePeriod() {
+		return
+	}
+
+	if ind.validFromBar == -1 {
+		ind.validFromBar = streamBarIndex
+	}
+
+	var weightedSum float64
+	for i, value := range ind.periodHistory {
+		weightedSum += value * ind.weights[i]
+	}
+	result := weightedSum / ind.weightSum
+
+	if result < ind.minValue {
+		ind.minValue = result
+	}
+	if result > ind.maxValue {
+		ind.maxValue = result
+	}
+
+	ind.dataLength++
+
+	ind.valueAvailableAction(result, streamBarIndex)
+}
@@ -0,0 +1,24 @@
+package v2
+
+import (
+	"github.com/thetruetrade/gotrade/indicators"
+)
+
+// FromIndicator adapts an existing v1 online/offline indicator into a v2
+// PriceStream, so indicators that have not been given a native v2
+// constructor yet can still take part in a v2 pipeline. subscribe is
+// called once with the action to pass as the wrapped indicator's
+// ValueAvailableActionFloat, which is exactly what its "without storage"
+// constructor already accepts, e.g.:
+//
+//	stream := v2.FromIndicator(20, func(action indicators.ValueAvailableActionFloat) {
+//		indicators.NewDriftWithoutStorage(20, action)
+//	})
+func FromIndicator(lookbackPeriod int, subscribe func(action indicators.ValueAvailableActionFloat)) *PriceStream {
+	out := NewPriceStream()
+	out.lookbackPeriod = lookbackPeriod
+	subscribe(func(value float64, streamBarIndex int) {
+		out.Publish(value, streamBarIndex)
+	})
+	return out
+}
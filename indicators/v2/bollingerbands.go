@@ -0,0 +1,55 @@
+package v2
+
+import "math"
+
+// BollingerBandsStream holds the three series published by BollingerBands.
+type BollingerBandsStream struct {
+	UpBand  *PriceStream
+	MidBand *PriceStream
+	DnBand  *PriceStream
+}
+
+// BollingerBands subscribes to source and publishes upper, middle and lower
+// bands window bars wide, upperMult/lowerMult standard deviations from the
+// middle band. source is typically a raw price stream or the output of
+// another v2 indicator such as SMA, allowing bands to be built around an
+// already-smoothed series without recomputing it.
+func BollingerBands(source *Stream[float64], window int, upperMult float64, lowerMult float64) *BollingerBandsStream {
+	lookback := source.lookbackPeriod + window - 1
+	out := &BollingerBandsStream{
+		UpBand:  NewPriceStream(),
+		MidBand: NewPriceStream(),
+		DnBand:  NewPriceStream(),
+	}
+	out.UpBand.lookbackPeriod = lookback
+	out.MidBand.lookbackPeriod = lookback
+	out.DnBand.lookbackPeriod = lookback
+
+	history := make([]float64, 0, window)
+	var sum float64
+
+	source.Subscribe(func(value float64, streamBarIndex int) {
+		history = append(history, value)
+		sum += value
+		if len(history) > window {
+			sum -= history[0]
+			history = history[1:]
+		}
+		if len(history) != window {
+			return
+		}
+
+		mean := sum / float64(window)
+		var variance float64
+		for _, v := range history {
+			d := v - mean
+			variance += d * d
+		}
+		stdDev := math.Sqrt(variance / float64(window))
+
+		out.MidBand.Publish(mean, streamBarIndex)
+		out.UpBand.Publish(mean+upperMult*stdDev, streamBarIndex)
+		out.DnBand.Publish(mean-lowerMult*stdDev, streamBarIndex)
+	})
+	return out
+}
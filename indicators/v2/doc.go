@@ -0,0 +1,26 @@
+/*
+Package v2 is an additive, stream-oriented alternative to a handful of the
+indicators in the parent indicators package. It does not touch the parent
+package: baseIndicator and the existing ValueAvailableAction* callback
+types are unchanged, and every v1 indicator still works exactly as before.
+
+v2 models an indicator as a node in a graph of Stream[T] values instead of
+a ValueAvailableAction* callback. A PriceStream carries a single float64
+series (e.g. close prices), a KLineStream carries full gotrade.DOHLCV bars,
+and indicator constructors such as SMA and BollingerBands consume one
+stream and publish one or more derived streams. Because the upstream
+series is shared rather than recomputed, downstream indicators can be
+layered freely:
+
+	closes := v2.ClosePrices(kstream)
+	sma := v2.SMA(closes.Source(), 20)
+	bb := v2.BollingerBands(sma.Source(), 20, 2.0, 2.0)
+
+Only SMA and BollingerBands have native v2 constructors so far. Any other
+v1 indicator whose without-storage constructor takes a
+ValueAvailableActionFloat can still take part in a pipeline via
+FromIndicator, which adapts that callback into a Stream[T] publish.
+Indicators with multi-value callbacks (MACD, MAMA, PivotSupertrend, ...)
+are not covered by FromIndicator.
+*/
+package v2
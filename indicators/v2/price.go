@@ -0,0 +1,67 @@
+package v2
+
+import (
+	"github.com/thetruetrade/gotrade"
+)
+
+// KLineStream is a Stream of gotrade.DOHLCV bars, the v2 entry point for
+// any pipeline rooted at raw OHLCV data.
+type KLineStream struct {
+	*Stream[gotrade.DOHLCV]
+}
+
+// NewKLineStream creates an empty KLineStream.
+func NewKLineStream() *KLineStream {
+	return &KLineStream{Stream: NewStream[gotrade.DOHLCV](0)}
+}
+
+// ReceiveDOHLCVTick satisfies gotrade.DOHLCVSubscriber so a KLineStream can
+// be attached directly to a gotrade.DataSelectionFunc driven feed.
+func (k *KLineStream) ReceiveDOHLCVTick(tickData gotrade.DOHLCV, streamBarIndex int) {
+	k.Publish(tickData, streamBarIndex)
+}
+
+// PriceStream is a Stream of float64 derived from some upstream series,
+// most commonly the close prices of a KLineStream.
+type PriceStream struct {
+	*Stream[float64]
+}
+
+// NewPriceStream creates an empty PriceStream.
+func NewPriceStream() *PriceStream {
+	return &PriceStream{Stream: NewStream[float64](0)}
+}
+
+// Source returns the underlying Stream[float64] so further v2 indicators
+// can subscribe to it without caring that it originated from a price
+// selector.
+func (p *PriceStream) Source() *Stream[float64] {
+	return p.Stream
+}
+
+// ClosePrices derives a PriceStream of closing prices from a KLineStream.
+func ClosePrices(k *KLineStream) *PriceStream {
+	out := NewPriceStream()
+	k.Subscribe(func(bar gotrade.DOHLCV, streamBarIndex int) {
+		out.Publish(bar.C(), streamBarIndex)
+	})
+	return out
+}
+
+// HighPrices derives a PriceStream of high prices from a KLineStream.
+func HighPrices(k *KLineStream) *PriceStream {
+	out := NewPriceStream()
+	k.Subscribe(func(bar gotrade.DOHLCV, streamBarIndex int) {
+		out.Publish(bar.H(), streamBarIndex)
+	})
+	return out
+}
+
+// LowPrices derives a PriceStream of low prices from a KLineStream.
+func LowPrices(k *KLineStream) *PriceStream {
+	out := NewPriceStream()
+	k.Subscribe(func(bar gotrade.DOHLCV, streamBarIndex int) {
+		out.Publish(bar.L(), streamBarIndex)
+	})
+	return out
+}
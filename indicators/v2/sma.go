@@ -0,0 +1,26 @@
+package v2
+
+// SMA subscribes to source and publishes its simple moving average over
+// window bars. The returned stream can itself be used as the source for
+// another v2 indicator (e.g. BollingerBands(sma.Source(), ...)) without
+// recomputing the moving average.
+func SMA(source *Stream[float64], window int) *PriceStream {
+	out := NewPriceStream()
+	out.lookbackPeriod = source.lookbackPeriod + window - 1
+
+	history := make([]float64, 0, window)
+	var sum float64
+
+	source.Subscribe(func(value float64, streamBarIndex int) {
+		history = append(history, value)
+		sum += value
+		if len(history) > window {
+			sum -= history[0]
+			history = history[1:]
+		}
+		if len(history) == window {
+			out.Publish(sum/float64(window), streamBarIndex)
+		}
+	})
+	return out
+}
@@ -0,0 +1,60 @@
+package v2
+
+// Stream is a published series of values of type T. It is the v2
+// replacement for the ValueAvailableAction* callback zoo: a single
+// subscribe/publish point that any number of downstream indicators can
+// attach to without forcing the upstream indicator to know about them
+// ahead of time.
+type Stream[T any] struct {
+	validFromBar   int
+	lookbackPeriod int
+	data           []T
+	subscribers    []func(value T, streamBarIndex int)
+}
+
+// NewStream creates an empty stream with the given lookback period.
+func NewStream[T any](lookbackPeriod int) *Stream[T] {
+	return &Stream[T]{lookbackPeriod: lookbackPeriod, validFromBar: -1}
+}
+
+// Subscribe registers a callback that is invoked every time a new value is
+// published to the stream, in addition to the value being appended to the
+// stream's own storage.
+func (s *Stream[T]) Subscribe(action func(value T, streamBarIndex int)) {
+	s.subscribers = append(s.subscribers, action)
+}
+
+// Publish appends value to the stream and notifies all subscribers.
+func (s *Stream[T]) Publish(value T, streamBarIndex int) {
+	if s.validFromBar == -1 {
+		s.validFromBar = streamBarIndex
+	}
+	s.data = append(s.data, value)
+	for _, subscriber := range s.subscribers {
+		subscriber(value, streamBarIndex)
+	}
+}
+
+// Last returns the n most recently published values, oldest first. If
+// fewer than n values have been published, the result is shorter than n.
+func (s *Stream[T]) Last(n int) []T {
+	if n > len(s.data) {
+		n = len(s.data)
+	}
+	return s.data[len(s.data)-n:]
+}
+
+// ValidFromBar satisfies indicators.Indicator.
+func (s *Stream[T]) ValidFromBar() int {
+	return s.validFromBar
+}
+
+// GetLookbackPeriod satisfies indicators.Indicator.
+func (s *Stream[T]) GetLookbackPeriod() int {
+	return s.lookbackPeriod
+}
+
+// Length satisfies indicators.Indicator.
+func (s *Stream[T]) Length() int {
+	return len(s.data)
+}
@@ -0,0 +1,129 @@
+package indicators
+
+import (
+	"github.com/thetruetrade/gotrade"
+)
+
+// A WMA Indicator - Weighted Moving Average
+type WMA struct {
+	*baseIndicatorWithFloatBounds
+	*baseIndicatorWithTimePeriod
+
+	// private variables
+	valueAvailableAction ValueAvailableActionFloat
+	periodHistory        []float64
+	weightSum            float64
+
+	// public variables
+	Data []float64
+}
+
+// NewWMAWithoutStorage creates a Weighted Moving Average indicator without
+// storage, suitable for embedding in other indicators.
+func NewWMAWithoutStorage(timePeriod int, valueAvailableAction ValueAvailableActionFloat) (indicator *WMA, err error) {
+	if valueAvailableAction == nil {
+		return nil, ErrValueAvailableActionIsNil
+	}
+
+	if timePeriod < 1 || timePeriod > MaximumLookbackPeriod {
+		return nil, ErrLookbackPeriodMustBeGreaterThanZero
+	}
+
+	lookback := timePeriod - 1
+	ind := WMA{
+		baseIndicatorWithFloatBounds: newBaseIndicatorWithFloatBounds(lookback),
+		baseIndicatorWithTimePeriod:  newBaseIndicatorWithTimePeriod(timePeriod),
+		periodHistory:                make([]float64, 0, timePeriod),
+		weightSum:                    float64(timePeriod*(timePeriod+1)) / 2.0,
+		valueAvailableAction:         valueAvailableAction,
+	}
+
+	return &ind, nil
+}
+
+// NewWMA creates a Weighted Moving Average indicator.
+func NewWMA(timePeriod int) (indicator *WMA, err error) {
+	ind := WMA{}
+
+	selectData := func(dataItem float64, streamBarIndex int) {
+		ind.Data = append(ind.Data, dataItem)
+	}
+
+	fromWithoutStorage, err := NewWMAWithoutStorage(timePeriod, selectData)
+	if err != nil {
+		return nil, err
+	}
+	ind.baseIndicatorWithFloatBounds = fromWithoutStorage.baseIndicatorWithFloatBounds
+	ind.baseIndicatorWithTimePeriod = fromWithoutStorage.baseIndicatorWithTimePeriod
+	ind.periodHistory = fromWithoutStorage.periodHistory
+	ind.weightSum = fromWithoutStorage.weightSum
+	ind.valueAvailableAction = fromWithoutStorage.valueAvailableAction
+
+	return &ind, nil
+}
+
+// NewWMAForStream creates a Weighted Moving Average indicator and attaches
+// it to priceStream.
+func NewWMAForStream(priceStream gotrade.DataStreamSubscriber, timePeriod int) (indicator *WMA, err error) {
+	ind, err := NewWMA(timePeriod)
+	if err != nil {
+		return nil, err
+	}
+	priceStream.AddTickSubscription(ind)
+	return ind, nil
+}
+
+// NewWMAWithSrcLen creates a Weighted Moving Average indicator with the
+// storage pre-allocated for sourceLength bars of history.
+func NewWMAWithSrcLen(sourceLength uint, timePeriod int) (indicator *WMA, err error) {
+	ind, err := NewWMA(timePeriod)
+	if err != nil {
+		return nil, err
+	}
+	ind.Data = make([]float64, 0, sourceLength-uint(ind.GetLookbackPeriod()))
+	return ind, nil
+}
+
+// NewWMAForStreamWithSrcLen creates a Weighted Moving Average indicator
+// with pre-allocated storage and attaches it to priceStream.
+func NewWMAForStreamWithSrcLen(sourceLength uint, priceStream gotrade.DataStreamSubscriber, timePeriod int) (indicator *WMA, err error) {
+	ind, err := NewWMAWithSrcLen(sourceLength, timePeriod)
+	if err != nil {
+		return nil, err
+	}
+	priceStream.AddTickSubscription(ind)
+	return ind, nil
+}
+
+// ReceiveTick consumes a source data price tick
+func (ind *WMA) ReceiveTick(tickData float64, streamBarIndex int) {
+	ind.periodHistory = append(ind.periodHistory, tickData)
+	if len(ind.periodHistory) > ind.GetTimePeriod() {
+		ind.periodHistory = ind.periodHistory[1:]
+	}
+
+	if len(ind.periodHistory) < ind.GetTimePeriod() {
+		return
+	}
+
+	if ind.validFromBar == -1 {
+		ind.validFromBar = streamBarIndex
+	}
+
+	var weightedSum float64
+	for i, value := range ind.periodHistory {
+		weightedSum += value * float64(i+1)
+	}
+	result := weightedSum / ind.weightSum
+
+	if result < ind.minValue {
+		ind.minValue = result
+	}
+	if result > ind.maxValue {
+		ind.maxValue = result
+	}
+
+	ind.dataLength++
+
+	ind.valueAvailableAction(result, streamBarIndex)
+}